@@ -0,0 +1,138 @@
+package fixedpoint
+
+import (
+	"encoding"
+	"encoding/binary"
+	"fmt"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = X64{}
+	_ encoding.BinaryUnmarshaler = (*X64)(nil)
+	_ encoding.BinaryAppender    = X64{}
+	_ encoding.BinaryMarshaler   = X32{}
+	_ encoding.BinaryUnmarshaler = (*X32)(nil)
+	_ encoding.BinaryAppender    = X32{}
+)
+
+// ErrInvalidLength is returned when binary input is not exactly the size of
+// the interchange format being decoded.
+var ErrInvalidLength = fmt.Errorf("invalid length")
+
+// FromBits64 returns the X64 whose IEEE 754 decimal64 BID encoding is bits.
+func FromBits64(bits uint64) X64 {
+	return X64{bits}
+}
+
+// Bits returns the IEEE 754 decimal64 BID encoding of x.
+func (x X64) Bits() uint64 {
+	return x.uint64
+}
+
+// AppendBytes appends the 8-byte interchange encoding of x to b in the given
+// byte order and returns the extended buffer.
+func (x X64) AppendBytes(b []byte, order binary.AppendByteOrder) []byte {
+	return order.AppendUint64(b, x.uint64)
+}
+
+// SetBytes sets x to the 8-byte interchange encoding in b, read in the given
+// byte order. It returns an error wrapping ErrInvalidLength if len(b) != 8.
+func (x *X64) SetBytes(b []byte, order binary.ByteOrder) error {
+	if x == nil {
+		return newInternalError(nil, "nil receiver")
+	}
+
+	if len(b) != 8 {
+		return fmt.Errorf("fixedpoint: X64 from %d bytes: %w", len(b), ErrInvalidLength)
+	}
+
+	x.uint64 = order.Uint64(b)
+	return nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+// It appends the big-endian interchange encoding of x.
+func (x X64) AppendBinary(b []byte) ([]byte, error) {
+	return x.AppendBytes(b, binary.BigEndian), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The result is the 8-byte big-endian interchange encoding of x.
+func (x X64) MarshalBinary() ([]byte, error) {
+	return x.AppendBinary(make([]byte, 0, 8))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It accepts the 8-byte big-endian interchange encoding.
+func (x *X64) UnmarshalBinary(data []byte) error {
+	return x.SetBytes(data, binary.BigEndian)
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (x X64) GobEncode() ([]byte, error) {
+	return x.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (x *X64) GobDecode(data []byte) error {
+	return x.UnmarshalBinary(data)
+}
+
+// FromBits32 returns the X32 whose IEEE 754 decimal32 BID encoding is bits.
+func FromBits32(bits uint32) X32 {
+	return X32{bits}
+}
+
+// Bits returns the IEEE 754 decimal32 BID encoding of x.
+func (x X32) Bits() uint32 {
+	return x.uint32
+}
+
+// AppendBytes appends the 4-byte interchange encoding of x to b in the given
+// byte order and returns the extended buffer.
+func (x X32) AppendBytes(b []byte, order binary.AppendByteOrder) []byte {
+	return order.AppendUint32(b, x.uint32)
+}
+
+// SetBytes sets x to the 4-byte interchange encoding in b, read in the given
+// byte order. It returns an error wrapping ErrInvalidLength if len(b) != 4.
+func (x *X32) SetBytes(b []byte, order binary.ByteOrder) error {
+	if x == nil {
+		return newInternalError(nil, "nil receiver")
+	}
+
+	if len(b) != 4 {
+		return fmt.Errorf("fixedpoint: X32 from %d bytes: %w", len(b), ErrInvalidLength)
+	}
+
+	x.uint32 = order.Uint32(b)
+	return nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+// It appends the big-endian interchange encoding of x.
+func (x X32) AppendBinary(b []byte) ([]byte, error) {
+	return x.AppendBytes(b, binary.BigEndian), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The result is the 4-byte big-endian interchange encoding of x.
+func (x X32) MarshalBinary() ([]byte, error) {
+	return x.AppendBinary(make([]byte, 0, 4))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It accepts the 4-byte big-endian interchange encoding.
+func (x *X32) UnmarshalBinary(data []byte) error {
+	return x.SetBytes(data, binary.BigEndian)
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (x X32) GobEncode() ([]byte, error) {
+	return x.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (x *X32) GobDecode(data []byte) error {
+	return x.UnmarshalBinary(data)
+}
@@ -0,0 +1,87 @@
+package fixedpoint
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalBinary64(t *testing.T) {
+	tests := []struct {
+		name  string
+		input X64
+		bits  uint64
+	}{
+		{"One", One64(), 0x31C0000000000001},
+		{"Negative", MustParse64("-123.45"), 0xB180000000003039},
+		{"Infinity", Inf64(1), 0x7800000000000000},
+		{"NaN", NaN64(), 0x7C00000000000000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.bits, tt.input.Bits())
+
+			data, err := tt.input.MarshalBinary()
+			require.NoError(t, err)
+			assert.Equal(t, binary.BigEndian.AppendUint64(nil, tt.bits), data)
+
+			le := tt.input.AppendBytes([]byte{0xFF}, binary.LittleEndian)
+			assert.Equal(t, binary.LittleEndian.AppendUint64([]byte{0xFF}, tt.bits), le)
+
+			var x X64
+			require.NoError(t, x.UnmarshalBinary(data))
+			assert.Equal(t, tt.input, x)
+
+			var y X64
+			require.NoError(t, y.SetBytes(le[1:], binary.LittleEndian))
+			assert.Equal(t, tt.input, y)
+		})
+	}
+
+	var x X64
+	assert.ErrorIs(t, x.UnmarshalBinary(make([]byte, 4)), ErrInvalidLength)
+	assert.ErrorIs(t, x.SetBytes(nil, binary.LittleEndian), ErrInvalidLength)
+}
+
+func TestMarshalBinary32(t *testing.T) {
+	x := MustParse32("-123.45")
+	assert.Equal(t, uint32(0xB1803039), x.Bits())
+
+	data, err := x.AppendBinary(nil)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xB1, 0x80, 0x30, 0x39}, data)
+	assert.Equal(t, []byte{0x39, 0x30, 0x80, 0xB1}, x.AppendBytes(nil, binary.LittleEndian))
+
+	var y X32
+	require.NoError(t, y.SetBytes([]byte{0x39, 0x30, 0x80, 0xB1}, binary.LittleEndian))
+	assert.Equal(t, x, y)
+	assert.Equal(t, x, FromBits32(0xB1803039))
+
+	assert.ErrorIs(t, y.UnmarshalBinary(make([]byte, 8)), ErrInvalidLength)
+}
+
+func TestNonCanonicalCoefficient(t *testing.T) {
+	assert.Equal(t, "X64{+, 0, 0}", FromBits64(0x6C77FFFFFFFFFFFF).Debug())
+	assert.Equal(t, "X32{+, 0, 0}", FromBits32(0x6CBFFFFF).Debug())
+}
+
+func TestGob(t *testing.T) {
+	type record struct {
+		Price X64
+		Qty   X32
+	}
+
+	in := record{MustParse64("-0.001"), MustParse32("42")}
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(in))
+
+	var out record
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+	assert.Equal(t, in, out)
+}
@@ -0,0 +1,79 @@
+package fixedpoint
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// ColumnOptions controls the output of AlignColumn and WriteColumn.
+type ColumnOptions struct {
+	Locale     Locale   // Supplies the decimal and grouping separators.
+	NoGrouping bool     // Omit grouping separators from the integer part.
+	Scale      int      // Fraction digits shown for every value; negative uses the largest scale in the column.
+	Rounding   Rounding // The rounding mode used when Scale drops digits.
+}
+
+// DefaultColumnOptions pads every value to the largest scale in the column
+// and groups the integer part.
+var DefaultColumnOptions = ColumnOptions{Locale: DefaultLocale, Scale: -1}
+
+// AlignColumn formats values as by Format with a common number of fraction
+// digits and pads them on the left to a common width, so that the decimal
+// separators line up when the cells are printed one above the other.
+// Infinities and NaNs end where the integer digits end. Widths are counted
+// in runes, so the cells also stay aligned in a text/tabwriter column.
+func AlignColumn[X X64 | X32](values []X, opts ColumnOptions) []string {
+	scale := opts.Scale
+	if scale < 0 {
+		scale = 0
+		for _, x := range values {
+			if v, err := components(x, opts.Rounding); err == nil && v.k == kind_finite {
+				scale = max(scale, -v.exp)
+			}
+		}
+	}
+
+	format := FormatOptions{
+		MinFractionDigits: scale,
+		MaxFractionDigits: scale,
+		RoundFraction:     true,
+		NoGrouping:        opts.NoGrouping,
+		Rounding:          opts.Rounding,
+	}
+
+	// Non-finite values take the place of the integer digits, followed by
+	// blanks where the fraction would be.
+	var blank string
+	if scale > 0 {
+		blank = strings.Repeat(" ", scale+1)
+	}
+
+	cells := make([]string, len(values))
+	width := 0
+	for i, x := range values {
+		s := Format(x, opts.Locale, format)
+		if v, err := components(x, opts.Rounding); err == nil && v.k != kind_finite {
+			s += blank
+		}
+		cells[i] = s
+		width = max(width, utf8.RuneCountInString(s))
+	}
+
+	for i, s := range cells {
+		if n := width - utf8.RuneCountInString(s); n > 0 {
+			cells[i] = strings.Repeat(" ", n) + s
+		}
+	}
+	return cells
+}
+
+// WriteColumn writes the cells of AlignColumn to w, one per line.
+func WriteColumn[X X64 | X32](w io.Writer, values []X, opts ColumnOptions) error {
+	for _, s := range AlignColumn(values, opts) {
+		if _, err := io.WriteString(w, s+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package fixedpoint
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlignColumn(t *testing.T) {
+	parse := func(values ...string) []X64 {
+		xs := make([]X64, len(values))
+		for i, s := range values {
+			xs[i] = MustParse64(s)
+		}
+		return xs
+	}
+
+	tests := []struct {
+		name     string
+		values   []X64
+		opts     ColumnOptions
+		expected []string
+	}{
+		{
+			"CommonScale",
+			parse("1234.5", "-12.345", "7", "0.05"),
+			DefaultColumnOptions,
+			[]string{
+				"1,234.500",
+				"  -12.345",
+				"    7.000",
+				"    0.050",
+			},
+		},
+		{
+			"Specials",
+			parse("1.25", "-Infinity", "NaN", "-1000"),
+			DefaultColumnOptions,
+			[]string{
+				"        1.25",
+				"-Infinity   ",
+				"      NaN   ",
+				"   -1,000.00",
+			},
+		},
+		{
+			"ZeroPositiveExponent",
+			parse("0E+3", "12.5", "-0E+2"),
+			DefaultColumnOptions,
+			[]string{
+				" 0.0",
+				"12.5",
+				"-0.0",
+			},
+		},
+		{
+			"FixedScale",
+			parse("1.005", "2.5", "-0.125"),
+			ColumnOptions{Locale: LocaleDeDE, Scale: 2},
+			[]string{
+				" 1,00",
+				" 2,50",
+				"-0,12",
+			},
+		},
+		{
+			"Integers",
+			parse("1234567", "1E+3", "-5"),
+			ColumnOptions{Locale: DefaultLocale, NoGrouping: true, Scale: -1},
+			[]string{
+				"1234567",
+				"   1000",
+				"     -5",
+			},
+		},
+		{
+			"Empty",
+			nil,
+			DefaultColumnOptions,
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, AlignColumn(tt.values, tt.opts))
+		})
+	}
+}
+
+func TestAlignColumnSpecialsWiden(t *testing.T) {
+	// An infinity wider than every number widens the whole column.
+	cells := AlignColumn([]X32{MustParse32("1.5"), MustParse32("-Infinity")}, DefaultColumnOptions)
+	assert.Equal(t, []string{"        1.5", "-Infinity  "}, cells)
+}
+
+func TestWriteColumn(t *testing.T) {
+	values := []X64{MustParse64("12.5"), MustParse64("-1234.75"), MustParse64("0.1")}
+
+	var b strings.Builder
+	require.NoError(t, WriteColumn(&b, values, DefaultColumnOptions))
+	assert.Equal(t, "    12.50\n-1,234.75\n     0.10\n", b.String())
+
+	// The cells have equal widths, so they stay aligned in a tabwriter column.
+	b.Reset()
+	tw := tabwriter.NewWriter(&b, 0, 0, 1, ' ', 0)
+	for i, cell := range AlignColumn(values, ColumnOptions{Locale: LocaleFrFR, Scale: -1}) {
+		_, err := tw.Write([]byte([]string{"rent", "insurance", "fee"}[i] + "\t" + cell + "\t\n"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Flush())
+	assert.Equal(t, "rent          12,50 \ninsurance -1\u00a0234,75 \nfee            0,10 \n", b.String())
+
+	assert.ErrorIs(t, WriteColumn(failingWriter{}, values, DefaultColumnOptions), errWrite)
+}
+
+var errWrite = errors.New("write failed")
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errWrite
+}
@@ -0,0 +1,131 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// CompactForm is a short form for large numbers: values of at least
+// 10^Exponent are divided by it and followed by Suffix.
+type CompactForm struct {
+	Exponent int
+	Suffix   string
+}
+
+// Common sets of compact forms, in ascending order of exponent.
+var (
+	CompactShort = []CompactForm{{3, "K"}, {6, "M"}, {9, "B"}, {12, "T"}}
+	CompactDeDE  = []CompactForm{{3, "\u00a0Tsd."}, {6, "\u00a0Mio."}, {9, "\u00a0Mrd."}, {12, "\u00a0Bio."}}
+	CompactEnIN  = []CompactForm{{3, "K"}, {5, "L"}, {7, "Cr"}}
+)
+
+// CompactOptions controls the output of FormatCompact.
+type CompactOptions struct {
+	Digits   int           // Maximum significant digits; the digits before the decimal point are always kept.
+	Forms    []CompactForm // The short forms in ascending order of exponent; nil selects CompactShort.
+	Locale   Locale        // Supplies the decimal separator.
+	Rounding Rounding      // The rounding mode used to drop digits.
+}
+
+// DefaultCompactOptions shows two significant digits with the K, M, B and T
+// suffixes, as in "1.2K" or "340M".
+var DefaultCompactOptions = CompactOptions{Digits: 2, Forms: CompactShort, Locale: DefaultLocale}
+
+// FormatCompact returns x scaled by the largest of opts.Forms that does not
+// exceed it and rounded to opts.Digits significant digits, such as "3.4M"
+// for 3,412,000. Trailing fraction zeros are dropped. Infinities and NaNs
+// use their ToSciString form.
+func FormatCompact[X X64 | X32](x X, opts CompactOptions) string {
+	var buf [32]byte
+	return string(AppendCompact(buf[:0], x, opts))
+}
+
+// AppendCompact appends x formatted as by FormatCompact to dst and returns
+// the extended buffer.
+func AppendCompact[X X64 | X32](dst []byte, x X, opts CompactOptions) []byte {
+	v, err := components(x, opts.Rounding)
+	if err != nil {
+		return appendError(dst, fmt.Sprintf("%T", x), err)
+	}
+
+	if v.k != kind_finite {
+		return appendToString(dst, v.k, v.sign, v.exp, v.coe, false)
+	}
+
+	forms := opts.Forms
+	if forms == nil {
+		forms = CompactShort
+	}
+
+	// Round in the form chosen for the value, then choose again in case
+	// rounding carried into the next form, as 999.96K becomes 1M.
+	coe, exp := v.coe, v.exp
+	var form CompactForm
+	for {
+		form = compactForm(forms, coe, exp)
+
+		adjusted := exp + int(countDigits(coe)) - 1
+		target := min(adjusted-max(opts.Digits, 1)+1, form.Exponent)
+		coe, exp = v.round(coe, exp, target)
+		if compactForm(forms, coe, exp) == form {
+			break
+		}
+	}
+
+	for exp < form.Exponent && coe != 0 && coe%10 == 0 {
+		coe, exp = coe/10, exp+1
+	}
+
+	if v.sign == signc_negative {
+		dst = append(dst, '-')
+	}
+
+	var buf [20]byte
+	digits := strconv.AppendUint(buf[:0], coe, 10)
+	point := len(digits) + exp - form.Exponent
+
+	switch {
+	case coe == 0:
+		dst = append(dst, '0')
+	case point <= 0:
+		dst = append(dst, '0')
+		dst = utf8.AppendRune(dst, compactDecimal(opts.Locale))
+		dst = appendZeros(dst, -point)
+		dst = append(dst, digits...)
+	case point >= len(digits):
+		dst = append(dst, digits...)
+		dst = appendZeros(dst, point-len(digits))
+	default:
+		dst = append(dst, digits[:point]...)
+		dst = utf8.AppendRune(dst, compactDecimal(opts.Locale))
+		dst = append(dst, digits[point:]...)
+	}
+
+	return append(dst, form.Suffix...)
+}
+
+// compactForm returns the form with the largest exponent not above the
+// adjusted exponent of coe × 10^exp, or the zero form if there is none.
+func compactForm(forms []CompactForm, coe uint64, exp int) CompactForm {
+	if coe == 0 {
+		return CompactForm{}
+	}
+
+	adjusted := exp + int(countDigits(coe)) - 1
+	var form CompactForm
+	for _, f := range forms {
+		if f.Exponent <= adjusted && f.Exponent > form.Exponent {
+			form = f
+		}
+	}
+	return form
+}
+
+// compactDecimal returns the decimal separator of l.
+func compactDecimal(l Locale) rune {
+	if r := firstRune(l.decimals); r != 0 {
+		return r
+	}
+	return '.'
+}
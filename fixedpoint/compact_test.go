@@ -0,0 +1,57 @@
+package fixedpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatCompact(t *testing.T) {
+	withDigits := func(digits int) CompactOptions {
+		opts := DefaultCompactOptions
+		opts.Digits = digits
+		return opts
+	}
+
+	tests := []struct {
+		name     string
+		value    string
+		opts     CompactOptions
+		expected string
+	}{
+		{"Small", "999", DefaultCompactOptions, "999"},
+		{"SmallFraction", "12.345", DefaultCompactOptions, "12"},
+		{"Fraction", "1.25", DefaultCompactOptions, "1.2"},
+		{"BelowOne", "0.001234", DefaultCompactOptions, "0.0012"},
+		{"Thousands", "1234", DefaultCompactOptions, "1.2K"},
+		{"Millions", "3412000", DefaultCompactOptions, "3.4M"},
+		{"Billions", "5000000000", DefaultCompactOptions, "5B"},
+		{"Trillions", "7.25E+12", DefaultCompactOptions, "7.2T"},
+		{"BeyondLastForm", "1.234E+15", DefaultCompactOptions, "1234T"},
+		{"KeepsIntegerDigits", "123456", DefaultCompactOptions, "123K"},
+		{"TrailingZeros", "1000", DefaultCompactOptions, "1K"},
+		{"Carry", "999960", DefaultCompactOptions, "1M"},
+		{"CarryWithinForm", "99960", DefaultCompactOptions, "100K"},
+		{"Negative", "-3450000", DefaultCompactOptions, "-3.4M"},
+		{"TieToEven", "3350000", DefaultCompactOptions, "3.4M"},
+		{"Zero", "0.00", DefaultCompactOptions, "0"},
+		{"ThreeDigits", "3456789", withDigits(3), "3.46M"},
+		{"OneDigit", "3456789", withDigits(1), "3M"},
+		{"DefaultForms", "2500", CompactOptions{Digits: 2}, "2.5K"},
+		{"RoundingMode", "3499999", CompactOptions{Digits: 2, Rounding: RoundTowardPositive}, "3.5M"},
+		{"German", "3412000", CompactOptions{Digits: 2, Forms: CompactDeDE, Locale: LocaleDeDE}, "3,4\u00a0Mio."},
+		{"Indian", "2500000", CompactOptions{Digits: 2, Forms: CompactEnIN, Locale: LocaleEnIN}, "25L"},
+		{"Crore", "123400000", CompactOptions{Digits: 3, Forms: CompactEnIN, Locale: LocaleEnIN}, "12.3Cr"},
+		{"Infinity", "-Infinity", DefaultCompactOptions, "-Infinity"},
+		{"NaN", "NaN", DefaultCompactOptions, "NaN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatCompact(MustParse64(tt.value), tt.opts))
+			assert.Equal(t, "$"+tt.expected, string(AppendCompact([]byte("$"), MustParse64(tt.value), tt.opts)))
+		})
+	}
+
+	assert.Equal(t, "-1.2M", FormatCompact(MustParse32("-1234567"), DefaultCompactOptions))
+}
@@ -0,0 +1,66 @@
+package fixedpoint
+
+import "fmt"
+
+// ContextConfig is the serializable form of a context, for reading contexts
+// from configuration files. Its fields marshal as text, so a JSON
+// configuration looks like:
+//
+//	{"precision": 12, "rounding": "RoundTiesToAway", "traps": "SignalOverflow|SignalInvalidOperation", "locale": "de-DE"}
+//
+// Unmarshal into a copy of DefaultContextConfig to default missing fields.
+type ContextConfig struct {
+	Precision Precision `json:"precision,omitempty"` // Zero selects PrecisionDefault64 or PrecisionDefault32.
+	Rounding  Rounding  `json:"rounding"`
+	Traps     Signal    `json:"traps"`
+	Locale    string    `json:"locale,omitempty"` // A tag known to LookupLocale; empty selects DefaultLocale.
+}
+
+// DefaultContextConfig describes the basic contexts.
+var DefaultContextConfig = ContextConfig{Rounding: BasicRounding, Traps: BasicTraps}
+
+// Context64 builds a Context64 from the configuration with NewContext64.
+func (c ContextConfig) Context64() (*Context64, error) {
+	p, l, err := c.resolve(PrecisionDefault64)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := NewContext64(p, c.Rounding, c.Traps, l)
+	if err != nil {
+		return nil, fmt.Errorf("fixedpoint: context config: %w", err)
+	}
+	return ctx, nil
+}
+
+// Context32 builds a Context32 from the configuration with NewContext32.
+func (c ContextConfig) Context32() (*Context32, error) {
+	p, l, err := c.resolve(PrecisionDefault32)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := NewContext32(p, c.Rounding, c.Traps, l)
+	if err != nil {
+		return nil, fmt.Errorf("fixedpoint: context config: %w", err)
+	}
+	return ctx, nil
+}
+
+// resolve applies the defaults for a missing precision and locale.
+func (c ContextConfig) resolve(defaultPrecision Precision) (Precision, Locale, error) {
+	p := c.Precision
+	if p == 0 {
+		p = defaultPrecision
+	}
+
+	if c.Locale == "" {
+		return p, DefaultLocale, nil
+	}
+
+	l, ok := LookupLocale(c.Locale)
+	if !ok {
+		return 0, Locale{}, fmt.Errorf("fixedpoint: context config: locale %q: %w", c.Locale, ErrInvalidLocale)
+	}
+	return p, l, nil
+}
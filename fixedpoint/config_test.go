@@ -0,0 +1,196 @@
+package fixedpoint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundingText(t *testing.T) {
+	for mode := DefaultRoundingMode; mode <= MaxRoundingMode; mode++ {
+		text, err := mode.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, mode.String(), string(text))
+
+		var got Rounding
+		require.NoError(t, got.UnmarshalText(text))
+		assert.Equal(t, mode, got)
+
+		require.NoError(t, got.UnmarshalText([]byte(mode.Debug())))
+		assert.Equal(t, mode, got)
+	}
+
+	tests := []struct {
+		input    string
+		expected Rounding
+	}{
+		{"roundtiestoaway", RoundTiesToAway},
+		{"TowardZero", RoundTowardZero},
+		{" towardnegative ", RoundTowardNegative},
+		{"ToP", RoundTowardPositive},
+	}
+	for _, tt := range tests {
+		var got Rounding
+		require.NoError(t, got.UnmarshalText([]byte(tt.input)), tt.input)
+		assert.Equal(t, tt.expected, got, tt.input)
+	}
+
+	var r Rounding = RoundTowardZero
+	assert.ErrorIs(t, r.UnmarshalText([]byte("HalfUp")), ErrUnknownRounding)
+	assert.ErrorIs(t, r.UnmarshalText([]byte("toe")), ErrUnknownRounding)
+	assert.Equal(t, RoundTowardZero, r)
+
+	_, err := Rounding(9).MarshalText()
+	assert.ErrorIs(t, err, ErrUnknownRounding)
+}
+
+func TestSignalText(t *testing.T) {
+	tests := []struct {
+		signal Signal
+		text   string
+		debug  string
+	}{
+		{SignalClear, "", "*"},
+		{SignalOverflow, "SignalOverflow", "o"},
+		{SignalOverflow | SignalInexact, "SignalOverflow|SignalInexact", "oi"},
+		{SignalInvalidOperation, "SignalInvalidOperation", "X"},
+		{SignalConversionSyntax, "SignalInvalidOperation|SignalConversionSyntax", "Xc"},
+		{BasicTraps, "SignalOverflow|SignalUnderflow|SignalInvalidOperation", "ouX"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			text, err := tt.signal.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, tt.text, string(text))
+			assert.Equal(t, tt.debug, tt.signal.Debug())
+
+			var got Signal
+			require.NoError(t, got.UnmarshalText(text))
+			assert.Equal(t, tt.signal, got)
+
+			got = SignalRounding
+			require.NoError(t, got.UnmarshalText([]byte(tt.debug)))
+			assert.Equal(t, tt.signal, got)
+		})
+	}
+
+	var s Signal
+	require.NoError(t, s.UnmarshalText([]byte("overflow | signalinexact|Clear")))
+	assert.Equal(t, SignalOverflow|SignalInexact, s)
+
+	require.NoError(t, s.UnmarshalText([]byte("SignalDivisionByZero|ri")))
+	assert.Equal(t, SignalDivisionByZero|SignalRounding|SignalInexact, s)
+
+	assert.ErrorIs(t, s.UnmarshalText([]byte("SignalOverflow|Overfow")), ErrUnknownSignal)
+	assert.ErrorIs(t, s.UnmarshalText([]byte("oz")), ErrUnknownSignal)
+	assert.Equal(t, SignalDivisionByZero|SignalRounding|SignalInexact, s)
+}
+
+func TestPrecisionText(t *testing.T) {
+	text, err := PrecisionMaximum64.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "16", string(text))
+
+	var p Precision
+	require.NoError(t, p.UnmarshalText([]byte(" 12 ")))
+	assert.Equal(t, Precision(12), p)
+
+	for _, s := range []string{"", "2", "17", "256", "-3", "twelve"} {
+		assert.ErrorIs(t, p.UnmarshalText([]byte(s)), ErrUnsupportedPrecision, s)
+	}
+	assert.Equal(t, Precision(12), p)
+
+	require.NoError(t, json.Unmarshal([]byte(`7`), &p))
+	assert.Equal(t, Precision(7), p)
+	require.NoError(t, json.Unmarshal([]byte(`"9"`), &p))
+	assert.Equal(t, Precision(9), p)
+	require.NoError(t, json.Unmarshal([]byte(`null`), &p))
+	assert.Equal(t, Precision(9), p)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`1.5`), &p), ErrUnsupportedPrecision)
+
+	for _, s := range []string{`"12`, `12"`, `""12""`, `"`} {
+		assert.ErrorIs(t, p.UnmarshalJSON([]byte(s)), ErrUnsupportedPrecision, s)
+	}
+	assert.Equal(t, Precision(9), p)
+
+	data, err := json.Marshal(struct {
+		Precision Precision `json:"precision"`
+	}{12})
+	require.NoError(t, err)
+	assert.Equal(t, `{"precision":12}`, string(data))
+}
+
+func TestContextConfig(t *testing.T) {
+	data := `{"precision": 12, "rounding": "RoundTiesToAway", "traps": "SignalOverflow|SignalInvalidOperation", "locale": "de-DE"}`
+
+	cfg := DefaultContextConfig
+	require.NoError(t, json.Unmarshal([]byte(data), &cfg))
+	assert.Equal(t, ContextConfig{12, RoundTiesToAway, SignalOverflow | SignalInvalidOperation, "de-DE"}, cfg)
+
+	ctx, err := cfg.Context64()
+	require.NoError(t, err)
+	assert.Equal(t, Precision(12), ctx.Precision())
+	assert.Equal(t, RoundTiesToAway, ctx.Rounding())
+	assert.Equal(t, SignalOverflow|SignalInvalidOperation, ctx.Traps())
+	assert.Equal(t, LocaleDeDE, ctx.Locale())
+
+	_, err = cfg.Context32()
+	assert.ErrorIs(t, err, ErrUnsupportedPrecision)
+
+	out, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `"precision":12`)
+	var back ContextConfig
+	require.NoError(t, json.Unmarshal(out, &back))
+	assert.Equal(t, cfg, back)
+}
+
+func TestContextConfigDefaults(t *testing.T) {
+	cfg := DefaultContextConfig
+	require.NoError(t, json.Unmarshal([]byte(`{"rounding": "TowardZero"}`), &cfg))
+
+	ctx64, err := cfg.Context64()
+	require.NoError(t, err)
+	assert.Equal(t, PrecisionDefault64, ctx64.Precision())
+	assert.Equal(t, RoundTowardZero, ctx64.Rounding())
+	assert.Equal(t, BasicTraps, ctx64.Traps())
+	assert.Equal(t, DefaultLocale, ctx64.Locale())
+
+	ctx32, err := cfg.Context32()
+	require.NoError(t, err)
+	assert.Equal(t, PrecisionDefault32, ctx32.Precision())
+
+	out, err := json.Marshal(DefaultContextConfig)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"rounding": "RoundTiesToEven", "traps": "SignalOverflow|SignalUnderflow|SignalInvalidOperation"}`, string(out))
+}
+
+func TestContextConfigErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{"Precision", `{"precision": "20"}`, ErrUnsupportedPrecision},
+		{"Rounding", `{"rounding": "HalfUp"}`, ErrUnknownRounding},
+		{"Traps", `{"traps": "SignalNothing"}`, ErrUnknownSignal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultContextConfig
+			assert.ErrorIs(t, json.Unmarshal([]byte(tt.data), &cfg), tt.err)
+		})
+	}
+
+	_, err := ContextConfig{Locale: "xx-XX"}.Context64()
+	assert.ErrorIs(t, err, ErrInvalidLocale)
+
+	_, err = ContextConfig{Rounding: Rounding(7)}.Context32()
+	assert.ErrorIs(t, err, ErrUnknownRounding)
+
+	_, err = ContextConfig{Precision: 2}.Context64()
+	assert.ErrorIs(t, err, ErrUnsupportedPrecision)
+}
@@ -101,6 +101,28 @@ func TestX32PackUnpackConformance(t *testing.T) {
 			exp:  -5,
 			coe:  67890,
 		},
+		{
+			name: "LargeCoefficient",
+			kind: kind_finite,
+			sign: signc_positive,
+			exp:  90,
+			coe:  9999999,
+		},
+		{
+			name: "SmallestExponent",
+			kind: kind_finite,
+			sign: signc_negative,
+			exp:  -101,
+			coe:  8388608,
+		},
+		{
+			name:      "ExponentOutOfRange",
+			kind:      kind_finite,
+			sign:      signc_positive,
+			exp:       91,
+			coe:       1,
+			expectErr: true,
+		},
 		{
 			name: "Infinity",
 			kind: kind_infinity,
@@ -165,6 +187,28 @@ func TestX64PackUnpackConformance(t *testing.T) {
 			exp:  -10,
 			coe:  987654321098765,
 		},
+		{
+			name: "LargeCoefficient",
+			kind: kind_finite,
+			sign: signc_positive,
+			exp:  369,
+			coe:  9999999999999999,
+		},
+		{
+			name: "SmallestExponent",
+			kind: kind_finite,
+			sign: signc_negative,
+			exp:  -398,
+			coe:  9007199254740992,
+		},
+		{
+			name:      "ExponentOutOfRange",
+			kind:      kind_finite,
+			sign:      signc_positive,
+			exp:       370,
+			coe:       1,
+			expectErr: true,
+		},
 		{
 			name: "Infinity",
 			kind: kind_infinity,
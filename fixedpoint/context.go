@@ -1,14 +1,10 @@
 package fixedpoint
 
-import (
-	"fmt"
-	"log"
-	"strconv"
-	"strings"
-)
+import "fmt"
 
 type Context[X X64 | X32] interface {
 	Parse(s string) X
+	ParseBytes(b []byte) X
 	HandleSignals(original, fallback X) X
 	ClearSignals()
 	Signal() Signal
@@ -34,21 +30,12 @@ type Context32 struct {
 
 // context holds the width-independent elements of the context.
 type context struct {
-	traps     Signal    // The current signal traps.
-	signals   Signal    // The current signal state.
-	precision Precision // The precision (number of significant digits).
-	rounding  Rounding  // The rounding mode.
-	locale    Locale    // The locale settings.
-}
-
-type Locale struct {
-	decimals  string // The decimal separators.
-	thousands string // The thousands separators.
-}
-
-var DefaultLocale = Locale{
-	decimals:  ".",
-	thousands: ",_",
+	traps     Signal       // The current signal traps.
+	signals   Signal       // The current signal state.
+	precision Precision    // The precision (number of significant digits).
+	rounding  Rounding     // The rounding mode.
+	locale    Locale       // The locale settings.
+	options   ParseOptions // The parse options.
 }
 
 // Default Basic Context Values.
@@ -107,65 +94,174 @@ func BasicContext64() *Context64 {
 	return c
 }
 
-// Parse converts a string into a FixedPoint value.
-// It handles special values (e.g., "NaN", "Infinity") and parses finite numbers.
-func (ctx *Context64) Parse(s string) X64 {
+// DefaultContext64 is the template context used by X64 methods that take no
+// context, such as Scan. Each call works on a clone with cleared signals, so
+// the template itself is never modified. It may be replaced during
+// initialization to change the precision, rounding, traps or locale those
+// methods use, but must not be replaced or modified once other goroutines
+// may use it. To choose a context per use, pass one explicitly, as with
+// NullX64.Context.
+var DefaultContext64 = func() *Context64 {
+	c, err := NewContext64(PrecisionMaximum64, BasicRounding, BasicTraps, DefaultLocale)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}()
+
+// DefaultContext32 is the template context used by X32 methods that take no
+// context. See DefaultContext64 for details.
+var DefaultContext32 = func() *Context32 {
+	c, err := NewContext32(PrecisionMaximum32, BasicRounding, BasicTraps, DefaultLocale)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}()
+
+// cleanContext64 returns a copy of ctx with cleared signals, or a clean copy
+// of DefaultContext64 if ctx is nil.
+func cleanContext64(ctx *Context64) *Context64 {
 	if ctx == nil {
-		ctx = BasicContext64()
+		return defaultContext64()
 	}
 
-	sign, kind, coe, exp, signals := parseInput(&ctx.context, s, maxCoefficient64, eMax64)
-	ctx.signals |= signals
-	if kind != kind_finite {
-		return newSpecial64(sign, kind)
+	return ctx.Clone(true)
+}
+
+// cleanContext32 returns a copy of ctx with cleared signals, or a clean copy
+// of DefaultContext32 if ctx is nil.
+func cleanContext32(ctx *Context32) *Context32 {
+	if ctx == nil {
+		return defaultContext32()
 	}
 
-	var a X64
-	err := a.pack(kind_finite, sign, exp, uint64(coe))
-	if err != nil {
-		ctx.signals |= SignalConversionSyntax
-		return newSpecial64(signc_positive, kind_signaling)
+	return ctx.Clone(true)
+}
+
+// defaultContext64 returns a clean copy of DefaultContext64.
+func defaultContext64() *Context64 {
+	if ctx := DefaultContext64.Clone(true); ctx != nil {
+		return ctx
 	}
 
-	err = a.Round(ctx.rounding, ctx.precision)
-	if err != nil {
-		log.Printf("Rounding error: %v", err)
-		ctx.signals |= SignalInvalidOperation
-		return newSpecial64(signc_positive, kind_signaling)
+	return BasicContext64()
+}
+
+// defaultContext32 returns a clean copy of DefaultContext32.
+func defaultContext32() *Context32 {
+	if ctx := DefaultContext32.Clone(true); ctx != nil {
+		return ctx
+	}
+
+	return BasicContext32()
+}
+
+// Parse converts a string into a FixedPoint value.
+// It handles special values (e.g., "NaN", "Infinity") and parses finite numbers,
+// optionally followed by an exponent as in "1.23E+5" or "5e-3". The result is
+// rounded to the context precision; exponents outside the format's range raise
+// SignalOverflow or SignalUnderflow.
+func (ctx *Context64) Parse(s string) X64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	x, _, _ := ctx.build(scanNumber(s, ctx.locale, ctx.options))
+	return x
+}
+
+// ParseBytes is like Parse, but reads the number from a byte slice.
+// It makes a single pass over b and does not allocate.
+func (ctx *Context64) ParseBytes(b []byte) X64 {
+	if ctx == nil {
+		ctx = BasicContext64()
 	}
 
-	return a
+	x, _, _ := ctx.build(scanNumber(b, ctx.locale, ctx.options))
+	return x
 }
 
+// Parse converts a string into a FixedPoint value.
+// See Context64.Parse for the accepted syntax.
 func (ctx *Context32) Parse(s string) X32 {
 	if ctx == nil {
 		ctx = BasicContext32()
 	}
 
-	sign, kind, coe, exp, signals := parseInput(&ctx.context, s, maxCoefficient32, eMax32)
-	ctx.signals |= signals
-	if kind != kind_finite {
-		return newSpecial32(sign, kind)
+	x, _, _ := ctx.build(scanNumber(s, ctx.locale, ctx.options))
+	return x
+}
+
+// ParseBytes is like Parse, but reads the number from a byte slice.
+// It makes a single pass over b and does not allocate.
+func (ctx *Context32) ParseBytes(b []byte) X32 {
+	if ctx == nil {
+		ctx = BasicContext32()
 	}
 
-	// Pack the parsed values into an X32 object.
-	var a X32
-	err := a.pack(kind_finite, sign, exp, uint32(coe))
+	x, _, _ := ctx.build(scanNumber(b, ctx.locale, ctx.options))
+	return x
+}
+
+// MustParse64 parses s at maximum precision and panics if s is not a valid
+// number or parsing raises a signal in BasicTraps. It simplifies the
+// initialization of package-level values.
+func MustParse64(s string) X64 {
+	ctx, err := NewContext64(PrecisionMaximum64, BasicRounding, BasicTraps, DefaultLocale)
 	if err != nil {
-		ctx.signals |= SignalConversionSyntax
-		return newSpecial32(signc_positive, kind_signaling)
+		panic(err)
+	}
+
+	x := ctx.Parse(s)
+	if ctx.signals&ctx.traps != 0 {
+		panic(fmt.Sprintf("fixedpoint: MustParse64(%q): %s", s, ctx.signals))
 	}
 
-	// Apply rounding to the result.
-	err = a.Round(ctx.rounding, ctx.precision)
+	return x
+}
+
+// MustParse32 parses s at maximum precision and panics if s is not a valid
+// number or parsing raises a signal in BasicTraps. It simplifies the
+// initialization of package-level values.
+func MustParse32(s string) X32 {
+	ctx, err := NewContext32(PrecisionMaximum32, BasicRounding, BasicTraps, DefaultLocale)
 	if err != nil {
-		// TODO: Add signal for rounding error
-		log.Printf("Rounding error: %v", err)
-		ctx.signals |= SignalInvalidOperation
-		return newSpecial32(signc_positive, kind_signaling)
+		panic(err)
+	}
+
+	x := ctx.Parse(s)
+	if ctx.signals&ctx.traps != 0 {
+		panic(fmt.Sprintf("fixedpoint: MustParse32(%q): %s", s, ctx.signals))
 	}
 
-	return a
+	return x
+}
+
+// finish rounds a finite result into the context and packs it as an X64.
+// sticky reports that nonzero digits below the last digit of coe were discarded.
+func (ctx *Context64) finish(sign signc, coe uint64, exp int, sticky bool) X64 {
+	k, coe, exp := ctx.round(sign, coe, exp, sticky, limits64)
+
+	var res X64
+	if err := res.pack(k, sign, int16(exp), coe); err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// finish rounds a finite result into the context and packs it as an X32.
+// sticky reports that nonzero digits below the last digit of coe were discarded.
+func (ctx *Context32) finish(sign signc, coe uint64, exp int, sticky bool) X32 {
+	k, coe, exp := ctx.round(sign, coe, exp, sticky, limits32)
+
+	var res X32
+	if err := res.pack(k, sign, int8(exp), uint32(coe)); err != nil {
+		panic(err)
+	}
+	return res
 }
 
 // Clone creates a copy of the context, optionally clearing the signal state.
@@ -174,18 +270,13 @@ func (ctx *Context64) Clone(clear bool) *Context64 {
 		return nil
 	}
 
-	signals := ctx.signals
+	clone := ctx.context
 	if clear {
-		signals = Signal(0)
+		clone.signals = Signal(0)
 	}
 
 	return &Context64{
-		context: context{
-			precision: ctx.precision,
-			rounding:  ctx.rounding,
-			traps:     ctx.traps,
-			signals:   signals,
-		},
+		context: clone,
 	}
 }
 
@@ -194,18 +285,13 @@ func (ctx *Context32) Clone(clear bool) *Context32 {
 		return nil
 	}
 
-	signals := ctx.signals
+	clone := ctx.context
 	if clear {
-		signals = Signal(0)
+		clone.signals = Signal(0)
 	}
 
 	return &Context32{
-		context: context{
-			precision: ctx.precision,
-			rounding:  ctx.rounding,
-			traps:     ctx.traps,
-			signals:   signals,
-		},
+		context: clone,
 	}
 }
 
@@ -383,111 +469,3 @@ func newContext(p Precision, r Rounding, traps Signal, l Locale, maxP Precision)
 		locale:    l,
 	}, nil
 }
-
-func parseInput[C uint64 | uint32, E int8 | int16](
-	ctx *context,
-	s string,
-	maxCoefficient C,
-	eMax E,
-) (signc, kind, C, E, Signal) {
-	if ctx == nil {
-		return signc_positive, kind_signaling, 0, 0, SignalInvalidOperation
-	}
-
-	s = normalizeInput(s, ctx.locale)
-	if s == "" {
-		return signc_positive, kind_signaling, 0, 0, SignalConversionSyntax
-	}
-
-	sign, kind, isSpecial := isSpecial(s)
-	if isSpecial {
-		return sign, kind, 0, 0, Signal(0)
-	}
-
-	sign, digits, exp, ok := getDigitString[E](s)
-	if !ok {
-		return signc_positive, kind_signaling, 0, 0, SignalConversionSyntax
-	}
-
-	value, err := strconv.ParseUint(digits, 10, 64)
-	if err != nil {
-		return signc_positive, kind_signaling, 0, 0, SignalConversionSyntax
-	}
-
-	if value > uint64(maxCoefficient) || exp > eMax {
-		return signc_positive, kind_signaling, 0, 0, SignalOverflow
-	}
-
-	return sign, kind, C(value), E(exp), Signal(0)
-}
-
-func normalizeInput(input string, locale Locale) string {
-	// Trim surrounding spaces.
-	input = strings.TrimSpace(input)
-	if input == "" {
-		return ""
-	}
-
-	input = strings.ToLower(input)
-	input = strings.ReplaceAll(input, " ", "")
-
-	for _, sep := range locale.decimals {
-		if sep != '.' {
-			input = strings.ReplaceAll(input, string(sep), ".")
-		}
-	}
-
-	for _, sep := range locale.thousands {
-		input = strings.ReplaceAll(input, string(sep), "")
-	}
-
-	return input
-}
-
-func isSpecial(s string) (signc, kind, bool) {
-	switch s {
-	case "nan", "+nan":
-		return signc_positive, kind_quiet, true
-	case "-nan":
-		return signc_negative, kind_quiet, true
-	case "inf", "infinity", "+inf", "+infinity":
-		return signc_positive, kind_infinity, true
-	case "-inf", "-infinity":
-		return signc_negative, kind_infinity, true
-	default:
-		return signc_error, kind_finite, false
-	}
-}
-
-func getDigitString[E int8 | int16](s string) (signc, string, E, bool) {
-	if s == "" {
-		return signc_positive, "", 0, false
-	}
-	// Determine s_sign.
-	s_sign := signc_positive
-	switch s[0] {
-	case '-':
-		s_sign = signc_negative
-		s = s[1:]
-	case '+':
-		s = s[1:]
-	}
-
-	parts := strings.Split(s, ".")
-	if len(parts) > 2 {
-		return signc_positive, "", 0, false
-	}
-
-	intPart := parts[0]
-	fracPart := ""
-	if len(parts) == 2 {
-		fracPart = parts[1]
-	}
-	if intPart == "" && fracPart == "" {
-		return signc_positive, "", 0, false
-	}
-
-	// Determine the exponent.
-	// For example, "123.45" becomes 12345 with an exponent of -2.
-	return s_sign, intPart + fracPart, E(-len(fracPart)), true
-}
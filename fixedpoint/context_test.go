@@ -25,6 +25,15 @@ func TestContext64Parse(t *testing.T) {
 		{"NaN", signc_positive, false, kind_quiet, 0, 0},
 		{"Infinity", signc_positive, false, kind_infinity, 0, 0},
 		{"-Infinity", signc_negative, false, kind_infinity, 0, 0},
+		{"1.23E+5", signc_positive, false, kind_finite, 3, 123},
+		{"5e-3", signc_positive, false, kind_finite, -3, 5},
+		{"-1.5e2", signc_negative, false, kind_finite, 1, 15},
+		{"12E0", signc_positive, false, kind_finite, 0, 12},
+		{"1e", signc_positive, true, kind_signaling, 0, 0},
+		{"1e+", signc_positive, true, kind_signaling, 0, 0},
+		{"e5", signc_positive, true, kind_signaling, 0, 0},
+		{"1.2e3.4", signc_positive, true, kind_signaling, 0, 0},
+		{"1e2e3", signc_positive, true, kind_signaling, 0, 0},
 	}
 
 	for _, tt := range tests {
@@ -68,6 +77,15 @@ func TestContext32Parse(t *testing.T) {
 		{"NaN", signc_positive, false, kind_quiet, 0, 0},
 		{"Infinity", signc_positive, false, kind_infinity, 0, 0},
 		{"-Infinity", signc_negative, false, kind_infinity, 0, 0},
+		{"1.23E+5", signc_positive, false, kind_finite, 3, 123},
+		{"5e-3", signc_positive, false, kind_finite, -3, 5},
+		{"-1.5e2", signc_negative, false, kind_finite, 1, 15},
+		{"12E0", signc_positive, false, kind_finite, 0, 12},
+		{"1e", signc_positive, true, kind_signaling, 0, 0},
+		{"1e+", signc_positive, true, kind_signaling, 0, 0},
+		{"e5", signc_positive, true, kind_signaling, 0, 0},
+		{"1.2e3.4", signc_positive, true, kind_signaling, 0, 0},
+		{"1e2e3", signc_positive, true, kind_signaling, 0, 0},
 	}
 
 	for _, tt := range tests {
@@ -91,3 +109,40 @@ func TestContext32Parse(t *testing.T) {
 		}
 	}
 }
+
+func TestParseExponentRange(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		signals  Signal
+	}{
+		{"1E384", "X64{+, 1000000000000000, 369}", SignalClear},
+		{"1E400", "X64{Inf, +}", SignalOverflow | SignalInexact | SignalRounding},
+		{"-9.9E999999999999", "X64{Inf, -}", SignalOverflow | SignalInexact | SignalRounding},
+		{"1E-398", "X64{+, 1, -398}", SignalClear},
+		{"1E-500", "X64{+, 0, -398}", SignalUnderflow | SignalInexact | SignalRounding},
+		{"0E-500", "X64{+, 0, -398}", SignalClear},
+		{"1.2345E-383", "X64{+, 12345, -387}", SignalClear},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ctx, err := NewContext64(PrecisionMaximum64, BasicRounding, SignalClear, DefaultLocale)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expected, ctx.Parse(tt.input).Debug())
+			assert.Equal(t, tt.signals, ctx.signals)
+		})
+	}
+
+	ctx32 := BasicContext32()
+	assert.Equal(t, "X32{Inf, +}", ctx32.Parse("1E97").Debug())
+	assert.NotZero(t, ctx32.signals&SignalOverflow)
+}
+
+func TestParseStringRoundTrip(t *testing.T) {
+	for _, s := range []string{"1.234e+13", "-5.6e-9", "123.45", "0.000001"} {
+		x := MustParse64(s)
+		assert.Equal(t, x, MustParse64(x.String()), "round trip of %q via %q", s, x.String())
+	}
+}
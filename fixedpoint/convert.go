@@ -0,0 +1,742 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+)
+
+// ErrNotFinite is returned when a NaN or an infinity is converted
+// to a type that cannot represent it.
+var ErrNotFinite = fmt.Errorf("value is not finite")
+
+// FromInt64 converts v to an X64. The result is exact when v has no more
+// significant digits than the context precision, and rounded otherwise.
+func (ctx *Context64) FromInt64(v int64) X64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	sign, coe := splitInt64(v)
+	return ctx.finish(sign, coe, 0, false)
+}
+
+// FromUint64 converts v to an X64. The result is exact when v has no more
+// significant digits than the context precision, and rounded otherwise.
+func (ctx *Context64) FromUint64(v uint64) X64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	return ctx.finish(signc_positive, v, 0, false)
+}
+
+// ToInt64 converts x to an int64, rounding any fractional part with the
+// context rounding mode. It raises SignalInexact when a nonzero fraction is
+// discarded, SignalOverflow when the result does not fit in an int64 and
+// SignalInvalidOperation when x is not finite. In the last two cases it returns 0.
+func (ctx *Context64) ToInt64(x X64) int64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		ctx.signals |= SignalInvalidOperation
+		return 0
+	}
+
+	return ctx.toInt64(k, sign, int(exp), coe)
+}
+
+// ToUint64 converts x to a uint64, rounding any fractional part with the
+// context rounding mode. It raises SignalInexact when a nonzero fraction is
+// discarded, SignalOverflow when the result is negative or does not fit in a
+// uint64 and SignalInvalidOperation when x is not finite. In the last two
+// cases it returns 0.
+func (ctx *Context64) ToUint64(x X64) uint64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		ctx.signals |= SignalInvalidOperation
+		return 0
+	}
+
+	return ctx.toUint64(k, sign, int(exp), coe)
+}
+
+// FromBigInt converts the scaled integer coe × 10^exp to an X64,
+// rounding to the context precision when necessary.
+func (ctx *Context64) FromBigInt(coe *big.Int, exp int) X64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	if coe == nil {
+		ctx.signals |= SignalInvalidOperation
+		return newSpecial64(signc_positive, kind_signaling)
+	}
+
+	sign, c, e, sticky := splitBigInt(coe, exp)
+	return ctx.finish(sign, c, e, sticky)
+}
+
+// FromRat converts r to an X64, rounding to the context precision when r
+// has no exact representation.
+func (ctx *Context64) FromRat(r *big.Rat) X64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	if r == nil {
+		ctx.signals |= SignalInvalidOperation
+		return newSpecial64(signc_positive, kind_signaling)
+	}
+
+	sign, c, e, sticky := splitRat(r)
+	return ctx.finish(sign, c, e, sticky)
+}
+
+// FromBigFloat converts f to an X64, rounding to the context precision when
+// f has more significant digits than the context allows.
+func (ctx *Context64) FromBigFloat(f *big.Float) X64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	if f == nil {
+		ctx.signals |= SignalInvalidOperation
+		return newSpecial64(signc_positive, kind_signaling)
+	}
+
+	if f.IsInf() {
+		return newSpecial64(signOf(f.Signbit()), kind_infinity)
+	}
+
+	sign, c, e, sticky := splitBigFloat(f)
+	return ctx.finish(sign, c, e, sticky)
+}
+
+// FromFloat64 converts f to the X64 holding the shortest decimal that
+// round-trips to f, as produced by strconv's shortest formatting, rounded to
+// the context precision when that decimal has more digits than allowed.
+// NaN converts to a quiet NaN and infinities convert to infinities.
+func (ctx *Context64) FromFloat64(f float64) X64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	switch {
+	case math.IsNaN(f):
+		return newSpecial64(signc_positive, kind_quiet)
+	case math.IsInf(f, 0):
+		return newSpecial64(signOf(f < 0), kind_infinity)
+	}
+
+	sign, coe, exp := splitFloat64(f)
+	return ctx.finish(sign, coe, exp, false)
+}
+
+// FromFloat64Exact converts the exact binary value of f to an X64,
+// rounded to the context precision. For example 0.1 converts to
+// 0.1000000000000000 at maximum precision, with SignalInexact raised for
+// the discarded ...055511151231257827 tail.
+func (ctx *Context64) FromFloat64Exact(f float64) X64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	switch {
+	case math.IsNaN(f):
+		return newSpecial64(signc_positive, kind_quiet)
+	case math.IsInf(f, 0):
+		return newSpecial64(signOf(f < 0), kind_infinity)
+	}
+
+	sign, coe, exp, sticky := splitFloat64Exact(f)
+	return ctx.finish(sign, coe, exp, sticky)
+}
+
+// ToFloat64 returns the float64 nearest to x, with ties rounded to even.
+// It raises SignalInexact when the result is not exactly x, SignalOverflow
+// when a finite x is beyond the float64 range and SignalUnderflow when an
+// inexact result is subnormal or zero. A signaling NaN raises
+// SignalInvalidOperation.
+func (ctx *Context64) ToFloat64(x X64) float64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		ctx.signals |= SignalInvalidOperation
+		return math.NaN()
+	}
+
+	return ctx.toFloat64(k, sign, int(exp), coe)
+}
+
+// BigInt returns the coefficient and exponent of x such that
+// x = coe × 10^exp exactly. It returns ErrNotFinite for NaN and infinities.
+func (x X64) BigInt() (*big.Int, int, error) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return toBigInt(k, sign, int(exp), coe)
+}
+
+// Rat returns the exact value of x as a big.Rat.
+// It returns ErrNotFinite for NaN and infinities.
+func (x X64) Rat() (*big.Rat, error) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return nil, err
+	}
+
+	return toRat(k, sign, int(exp), coe)
+}
+
+// BigFloat returns x as a big.Float with the given precision in bits,
+// rounded to nearest even when x has no exact binary representation.
+// A precision of 0 selects enough bits to hold the coefficient exactly.
+// The accuracy of the result is reported by its Acc method.
+// It returns ErrNotFinite for NaN.
+func (x X64) BigFloat(prec uint) (*big.Float, error) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return nil, err
+	}
+
+	return toBigFloat(k, sign, int(exp), coe, prec)
+}
+
+// FromFloat64 converts f to the X32 holding the shortest decimal that
+// round-trips to f, rounded to the context precision.
+// See Context64.FromFloat64 for details.
+func (ctx *Context32) FromFloat64(f float64) X32 {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	switch {
+	case math.IsNaN(f):
+		return newSpecial32(signc_positive, kind_quiet)
+	case math.IsInf(f, 0):
+		return newSpecial32(signOf(f < 0), kind_infinity)
+	}
+
+	sign, coe, exp := splitFloat64(f)
+	return ctx.finish(sign, coe, exp, false)
+}
+
+// FromFloat64Exact converts the exact binary value of f to an X32,
+// rounded to the context precision.
+func (ctx *Context32) FromFloat64Exact(f float64) X32 {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	switch {
+	case math.IsNaN(f):
+		return newSpecial32(signc_positive, kind_quiet)
+	case math.IsInf(f, 0):
+		return newSpecial32(signOf(f < 0), kind_infinity)
+	}
+
+	sign, coe, exp, sticky := splitFloat64Exact(f)
+	return ctx.finish(sign, coe, exp, sticky)
+}
+
+// ToFloat64 returns the float64 nearest to x, with ties rounded to even.
+// Signals are raised as for Context64.ToFloat64.
+func (ctx *Context32) ToFloat64(x X32) float64 {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		ctx.signals |= SignalInvalidOperation
+		return math.NaN()
+	}
+
+	return ctx.toFloat64(k, sign, int(exp), uint64(coe))
+}
+
+// FromInt64 converts v to an X32. The result is exact when v has no more
+// significant digits than the context precision, and rounded otherwise.
+func (ctx *Context32) FromInt64(v int64) X32 {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	sign, coe := splitInt64(v)
+	return ctx.finish(sign, coe, 0, false)
+}
+
+// FromUint64 converts v to an X32. The result is exact when v has no more
+// significant digits than the context precision, and rounded otherwise.
+func (ctx *Context32) FromUint64(v uint64) X32 {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	return ctx.finish(signc_positive, v, 0, false)
+}
+
+// ToInt64 converts x to an int64, rounding any fractional part with the
+// context rounding mode. Signals are raised as for Context64.ToInt64.
+func (ctx *Context32) ToInt64(x X32) int64 {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		ctx.signals |= SignalInvalidOperation
+		return 0
+	}
+
+	return ctx.toInt64(k, sign, int(exp), uint64(coe))
+}
+
+// ToUint64 converts x to a uint64, rounding any fractional part with the
+// context rounding mode. Signals are raised as for Context64.ToUint64.
+func (ctx *Context32) ToUint64(x X32) uint64 {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		ctx.signals |= SignalInvalidOperation
+		return 0
+	}
+
+	return ctx.toUint64(k, sign, int(exp), uint64(coe))
+}
+
+// FromBigInt converts the scaled integer coe × 10^exp to an X32,
+// rounding to the context precision when necessary.
+func (ctx *Context32) FromBigInt(coe *big.Int, exp int) X32 {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	if coe == nil {
+		ctx.signals |= SignalInvalidOperation
+		return newSpecial32(signc_positive, kind_signaling)
+	}
+
+	sign, c, e, sticky := splitBigInt(coe, exp)
+	return ctx.finish(sign, c, e, sticky)
+}
+
+// FromRat converts r to an X32, rounding to the context precision when r
+// has no exact representation.
+func (ctx *Context32) FromRat(r *big.Rat) X32 {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	if r == nil {
+		ctx.signals |= SignalInvalidOperation
+		return newSpecial32(signc_positive, kind_signaling)
+	}
+
+	sign, c, e, sticky := splitRat(r)
+	return ctx.finish(sign, c, e, sticky)
+}
+
+// FromBigFloat converts f to an X32, rounding to the context precision when
+// f has more significant digits than the context allows.
+func (ctx *Context32) FromBigFloat(f *big.Float) X32 {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	if f == nil {
+		ctx.signals |= SignalInvalidOperation
+		return newSpecial32(signc_positive, kind_signaling)
+	}
+
+	if f.IsInf() {
+		return newSpecial32(signOf(f.Signbit()), kind_infinity)
+	}
+
+	sign, c, e, sticky := splitBigFloat(f)
+	return ctx.finish(sign, c, e, sticky)
+}
+
+// BigInt returns the coefficient and exponent of x such that
+// x = coe × 10^exp exactly. It returns ErrNotFinite for NaN and infinities.
+func (x X32) BigInt() (*big.Int, int, error) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return toBigInt(k, sign, int(exp), uint64(coe))
+}
+
+// Rat returns the exact value of x as a big.Rat.
+// It returns ErrNotFinite for NaN and infinities.
+func (x X32) Rat() (*big.Rat, error) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return nil, err
+	}
+
+	return toRat(k, sign, int(exp), uint64(coe))
+}
+
+// BigFloat returns x as a big.Float with the given precision in bits.
+// See X64.BigFloat for details.
+func (x X32) BigFloat(prec uint) (*big.Float, error) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return nil, err
+	}
+
+	return toBigFloat(k, sign, int(exp), uint64(coe), prec)
+}
+
+// toInteger rounds a value to an integral magnitude using the context
+// rounding mode. ok is false when the value is not finite or the
+// magnitude does not fit in a uint64.
+func (ctx *context) toInteger(k kind, sign signc, exp int, coe uint64) (uint64, bool) {
+	if k != kind_finite {
+		ctx.signals |= SignalInvalidOperation
+		return 0, false
+	}
+
+	if exp >= 0 {
+		if coe == 0 {
+			return 0, true
+		}
+
+		if exp > 19 {
+			ctx.signals |= SignalOverflow
+			return 0, false
+		}
+
+		hi, lo := bits.Mul64(coe, pow10[uint64](uint(exp)))
+		if hi != 0 {
+			ctx.signals |= SignalOverflow
+			return 0, false
+		}
+
+		return lo, true
+	}
+
+	quotient, rem := shiftRight(coe, -exp, false)
+	if roundUp(ctx.rounding, sign, quotient&1 == 1, rem) {
+		quotient++
+	}
+
+	if rem != rem_zero {
+		ctx.signals |= SignalInexact | SignalRounding
+	}
+
+	return quotient, true
+}
+
+func (ctx *context) toInt64(k kind, sign signc, exp int, coe uint64) int64 {
+	u, ok := ctx.toInteger(k, sign, exp, coe)
+	switch {
+	case !ok:
+		return 0
+	case sign == signc_negative && u > 1<<63, sign == signc_positive && u > math.MaxInt64:
+		ctx.signals |= SignalOverflow
+		return 0
+	case sign == signc_negative:
+		return -int64(u)
+	default:
+		return int64(u)
+	}
+}
+
+func (ctx *context) toUint64(k kind, sign signc, exp int, coe uint64) uint64 {
+	u, ok := ctx.toInteger(k, sign, exp, coe)
+	switch {
+	case !ok:
+		return 0
+	case sign == signc_negative && u != 0:
+		ctx.signals |= SignalOverflow
+		return 0
+	default:
+		return u
+	}
+}
+
+// pow10Float64 holds the powers of ten used by the fast conversion path,
+// all of which are exact in a float64.
+var pow10Float64 = [...]float64{
+	1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+}
+
+// toFloat64 converts a value to the nearest float64.
+func (ctx *context) toFloat64(k kind, sign signc, exp int, coe uint64) float64 {
+	switch k {
+	case kind_signaling:
+		ctx.signals |= SignalInvalidOperation
+		return math.NaN()
+	case kind_quiet:
+		return math.NaN()
+	case kind_infinity:
+		return math.Inf(int(sign))
+	}
+
+	if coe == 0 {
+		return math.Copysign(0, float64(sign))
+	}
+
+	f, exact, ok := fastFloat64(coe, exp)
+	if !ok {
+		r, _ := toRat(k, signc_positive, exp, coe)
+		f, exact = r.Float64()
+	}
+
+	if !exact {
+		ctx.signals |= SignalInexact | SignalRounding
+		switch {
+		case math.IsInf(f, 0):
+			ctx.signals |= SignalOverflow
+		case f < 0x1p-1022:
+			ctx.signals |= SignalUnderflow
+		}
+	}
+
+	return math.Copysign(f, float64(sign))
+}
+
+// fastFloat64 converts coe × 10^exp to a float64 with a single correctly
+// rounded operation when both operands are exact in a float64, reporting
+// whether the result is exact. ok is false when the fast path does not apply.
+func fastFloat64(coe uint64, exp int) (f float64, exact, ok bool) {
+	if coe >= 1<<53 || exp < -19 || exp > 19 {
+		return 0, false, false
+	}
+
+	f = float64(coe)
+	if exp >= 0 {
+		// coe × 10^exp is exact when its odd part fits in the mantissa.
+		hi, lo := bits.Mul64(coe, pow10[uint64](uint(exp))>>uint(exp))
+		odd := lo >> bits.TrailingZeros64(lo)
+		return f * pow10Float64[exp], hi == 0 && odd < 1<<53, true
+	}
+
+	// coe / 10^k is exact when 5^k divides coe.
+	return f / pow10Float64[-exp], coe%(pow10[uint64](uint(-exp))>>uint(-exp)) == 0, true
+}
+
+// splitFloat64 returns the sign, coefficient and exponent of the shortest
+// decimal that round-trips to the finite f.
+func splitFloat64(f float64) (signc, uint64, int) {
+	var buf [32]byte
+	b := strconv.AppendFloat(buf[:0], math.Abs(f), 'e', -1, 64)
+
+	// b is d[.ddd]e±dd[d]
+	var coe uint64
+	var digits, i int
+	for ; b[i] != 'e'; i++ {
+		if b[i] != '.' {
+			coe = coe*10 + uint64(b[i]-'0')
+			digits++
+		}
+	}
+
+	var exp int
+	for _, c := range b[i+2:] {
+		exp = exp*10 + int(c-'0')
+	}
+	if b[i+1] == '-' {
+		exp = -exp
+	}
+
+	return signOf(math.Signbit(f)), coe, exp - (digits - 1)
+}
+
+// splitFloat64Exact reduces the exact binary value of the finite f to a sign,
+// a coefficient and an exponent, reporting whether nonzero digits were
+// discarded.
+func splitFloat64Exact(f float64) (signc, uint64, int, bool) {
+	sign := signOf(math.Signbit(f))
+	if f == 0 {
+		return sign, 0, 0, false
+	}
+
+	frac, exp2 := math.Frexp(math.Abs(f))
+	mant := uint64(frac * (1 << 53))
+	exp2 -= 53
+
+	// Drop factors of two so the decimal expansion has no trailing zeros.
+	for exp2 < 0 && mant&1 == 0 {
+		mant >>= 1
+		exp2++
+	}
+
+	v := new(big.Int).SetUint64(mant)
+	if exp2 >= 0 {
+		v.Lsh(v, uint(exp2))
+		_, coe, exp, sticky := splitBigInt(v, 0)
+		return sign, coe, exp, sticky
+	}
+
+	// mant × 2^-k = mant × 5^k × 10^-k
+	five := new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(-exp2)), nil)
+	_, coe, exp, sticky := splitBigInt(v.Mul(v, five), exp2)
+	return sign, coe, exp, sticky
+}
+
+// signOf returns the sign matching a sign bit.
+func signOf(negative bool) signc {
+	if negative {
+		return signc_negative
+	}
+	return signc_positive
+}
+
+// splitInt64 returns the sign and magnitude of v.
+func splitInt64(v int64) (signc, uint64) {
+	if v < 0 {
+		return signc_negative, uint64(-(v + 1)) + 1
+	}
+	return signc_positive, uint64(v)
+}
+
+// splitBigInt reduces the scaled integer v × 10^exp to a sign, a coefficient
+// of at most 19 digits and an exponent, reporting whether nonzero digits
+// were discarded.
+func splitBigInt(v *big.Int, exp int) (signc, uint64, int, bool) {
+	sign := signOf(v.Sign() < 0)
+	abs := new(big.Int).Abs(v)
+	if abs.IsUint64() {
+		return sign, abs.Uint64(), exp, false
+	}
+
+	drop := len(abs.Text(10)) - 19
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(drop)), nil)
+	quotient, rest := abs.QuoRem(abs, divisor, new(big.Int))
+
+	return sign, quotient.Uint64(), exp + drop, rest.Sign() != 0
+}
+
+// splitRat reduces r to a sign, a coefficient of 18 or 19 digits and an
+// exponent, reporting whether nonzero digits were discarded.
+func splitRat(r *big.Rat) (signc, uint64, int, bool) {
+	if r.IsInt() {
+		return splitBigInt(r.Num(), 0)
+	}
+
+	sign := signOf(r.Sign() < 0)
+	num := new(big.Int).Abs(r.Num())
+	den := new(big.Int).Set(r.Denom())
+
+	// Scale so that the quotient has 18 or 19 digits.
+	scale := 18 - len(num.Text(10)) + len(den.Text(10))
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(scale))), nil)
+	if scale >= 0 {
+		num.Mul(num, factor)
+	} else {
+		den.Mul(den, factor)
+	}
+
+	quotient, rest := num.QuoRem(num, den, new(big.Int))
+	coe, exp := quotient.Uint64(), -scale
+	if rest.Sign() != 0 {
+		return sign, coe, exp, true
+	}
+
+	// Exact quotients keep no more fractional digits than they need.
+	for exp < 0 && coe%10 == 0 {
+		coe /= 10
+		exp++
+	}
+
+	return sign, coe, exp, false
+}
+
+// splitBigFloat reduces a finite f to a sign, a coefficient and an exponent,
+// reporting whether nonzero digits were discarded.
+func splitBigFloat(f *big.Float) (signc, uint64, int, bool) {
+	sign := signOf(f.Signbit())
+	if f.Sign() == 0 {
+		return sign, 0, 0, false
+	}
+
+	// Magnitudes far outside the decimal range are replaced by a stand-in
+	// that rounds identically, rather than expanding them into huge rationals.
+	switch exp2 := f.MantExp(nil); {
+	case exp2 > 1300:
+		return sign, 1, math.MaxInt16, false
+	case exp2 < -1400:
+		return sign, 1, math.MinInt16, false
+	}
+
+	r, _ := f.Rat(nil)
+	return splitRat(r)
+}
+
+func toBigInt(k kind, sign signc, exp int, coe uint64) (*big.Int, int, error) {
+	if k != kind_finite {
+		return nil, 0, ErrNotFinite
+	}
+
+	v := new(big.Int).SetUint64(coe)
+	if sign == signc_negative {
+		v.Neg(v)
+	}
+
+	return v, exp, nil
+}
+
+func toRat(k kind, sign signc, exp int, coe uint64) (*big.Rat, error) {
+	v, exp, err := toBigInt(k, sign, exp, coe)
+	if err != nil {
+		return nil, err
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(exp))), nil)
+	if exp >= 0 {
+		return new(big.Rat).SetInt(v.Mul(v, scale)), nil
+	}
+
+	return new(big.Rat).SetFrac(v, scale), nil
+}
+
+func toBigFloat(k kind, sign signc, exp int, coe uint64, prec uint) (*big.Float, error) {
+	switch k {
+	case kind_quiet, kind_signaling:
+		return nil, ErrNotFinite
+	case kind_infinity:
+		return new(big.Float).SetInf(sign == signc_negative), nil
+	}
+
+	r, err := toRat(k, sign, exp, coe)
+	if err != nil {
+		return nil, err
+	}
+
+	f := new(big.Float).SetPrec(prec).SetRat(r)
+	if sign == signc_negative && coe == 0 {
+		f.Neg(f)
+	}
+
+	return f, nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,389 @@
+package fixedpoint
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContext64FromInt64(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision Precision
+		rounding  Rounding
+		input     int64
+		expected  string
+		signals   Signal
+	}{
+		{"Zero", PrecisionMaximum64, RoundTiesToEven, 0, "X64{+, 0, 0}", 0},
+		{"Positive", PrecisionMaximum64, RoundTiesToEven, 12345, "X64{+, 12345, 0}", 0},
+		{"Negative", PrecisionMaximum64, RoundTiesToEven, -12345, "X64{-, 12345, 0}", 0},
+		{"MaxCoefficient", PrecisionMaximum64, RoundTiesToEven, 9999999999999999, "X64{+, 9999999999999999, 0}", 0},
+		{"MaxInt64", PrecisionMaximum64, RoundTiesToEven, math.MaxInt64, "X64{+, 9223372036854776, 3}", SignalInexact | SignalRounding},
+		{"MinInt64", PrecisionMaximum64, RoundTiesToEven, math.MinInt64, "X64{-, 9223372036854776, 3}", SignalInexact | SignalRounding},
+		{"RoundedToPrecision", PrecisionDefault64, RoundTiesToEven, 1234567895, "X64{+, 123456790, 1}", SignalInexact | SignalRounding},
+		{"RoundedTowardZero", PrecisionDefault64, RoundTowardZero, -1234567899, "X64{-, 123456789, 1}", SignalInexact | SignalRounding},
+		{"RoundedExactZeros", PrecisionDefault64, RoundTiesToEven, 1234567890, "X64{+, 123456789, 1}", SignalRounding},
+		{"Carry", PrecisionMinimum, RoundTiesToEven, 9999, "X64{+, 100, 2}", SignalInexact | SignalRounding},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := NewContext64(tt.precision, tt.rounding, BasicTraps, DefaultLocale)
+			require.NoError(t, err)
+
+			got := ctx.FromInt64(tt.input)
+			assert.Equal(t, tt.expected, got.Debug())
+			assert.Equal(t, tt.signals, ctx.Signal())
+		})
+	}
+}
+
+func TestContext64FromUint64(t *testing.T) {
+	ctx, err := NewContext64(PrecisionMaximum64, RoundTiesToEven, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	got := ctx.FromUint64(math.MaxUint64)
+	assert.Equal(t, "X64{+, 1844674407370955, 4}", got.Debug())
+	assert.Equal(t, SignalInexact|SignalRounding, ctx.Signal())
+}
+
+func TestContext32FromInt64(t *testing.T) {
+	ctx, err := NewContext32(PrecisionMaximum32, RoundTiesToEven, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	assert.Equal(t, "X32{-, 9999999, 0}", ctx.FromInt64(-9999999).Debug())
+	assert.Equal(t, SignalClear, ctx.Signal())
+
+	assert.Equal(t, "X32{+, 1234568, 3}", ctx.FromInt64(1234567890).Debug())
+	assert.Equal(t, SignalInexact|SignalRounding, ctx.Signal())
+}
+
+func TestContext64ToInt64(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		rounding Rounding
+		expected int64
+		signals  Signal
+	}{
+		{"Integer", "12345", RoundTiesToEven, 12345, 0},
+		{"Negative", "-12345", RoundTiesToEven, -12345, 0},
+		{"TrailingZeros", "123.000", RoundTiesToEven, 123, 0},
+		{"TiesToEven", "2.5", RoundTiesToEven, 2, SignalInexact | SignalRounding},
+		{"TiesToAway", "2.5", RoundTiesToAway, 3, SignalInexact | SignalRounding},
+		{"TowardNegative", "-2.1", RoundTowardNegative, -3, SignalInexact | SignalRounding},
+		{"TowardZero", "-2.9", RoundTowardZero, -2, SignalInexact | SignalRounding},
+		{"TinyFraction", "0.0000000000000001", RoundTowardPositive, 1, SignalInexact | SignalRounding},
+		{"NaN", "NaN", RoundTiesToEven, 0, SignalInvalidOperation},
+		{"Infinity", "-Infinity", RoundTiesToEven, 0, SignalInvalidOperation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := NewContext64(PrecisionMaximum64, tt.rounding, BasicTraps, DefaultLocale)
+			require.NoError(t, err)
+
+			x := ctx.Parse(tt.input)
+			ctx.ClearSignals()
+
+			assert.Equal(t, tt.expected, ctx.ToInt64(x))
+			assert.Equal(t, tt.signals, ctx.Signal())
+		})
+	}
+}
+
+func TestContext64ToInt64Overflow(t *testing.T) {
+	ctx, err := NewContext64(PrecisionMaximum64, RoundTiesToEven, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	var x X64
+	require.NoError(t, x.pack(kind_finite, signc_positive, 3, 9223372036854776))
+	assert.Equal(t, int64(0), ctx.ToInt64(x))
+	assert.Equal(t, SignalOverflow, ctx.Signal())
+
+	ctx.ClearSignals()
+	require.NoError(t, x.pack(kind_finite, signc_negative, 3, 9223372036854775))
+	assert.Equal(t, int64(-9223372036854775000), ctx.ToInt64(x))
+	assert.Equal(t, SignalClear, ctx.Signal())
+
+	ctx.ClearSignals()
+	require.NoError(t, x.pack(kind_finite, signc_positive, 30, 1))
+	assert.Equal(t, uint64(0), ctx.ToUint64(x))
+	assert.Equal(t, SignalOverflow, ctx.Signal())
+
+	ctx.ClearSignals()
+	require.NoError(t, x.pack(kind_finite, signc_negative, 0, 1))
+	assert.Equal(t, uint64(0), ctx.ToUint64(x))
+	assert.Equal(t, SignalOverflow, ctx.Signal())
+
+	ctx.ClearSignals()
+	require.NoError(t, x.pack(kind_finite, signc_negative, -1, 4))
+	assert.Equal(t, uint64(0), ctx.ToUint64(x))
+	assert.Equal(t, SignalInexact|SignalRounding, ctx.Signal())
+}
+
+func TestContext64FromBigInt(t *testing.T) {
+	ctx, err := NewContext64(PrecisionMaximum64, RoundTiesToEven, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	v, ok := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	assert.Equal(t, "X64{-, 1234567890123457, 12}", ctx.FromBigInt(v, -2).Debug())
+	assert.Equal(t, SignalInexact|SignalRounding, ctx.Signal())
+
+	ctx.ClearSignals()
+	assert.Equal(t, "X64{+, 12345, -2}", ctx.FromBigInt(big.NewInt(12345), -2).Debug())
+	assert.Equal(t, SignalClear, ctx.Signal())
+
+	ctx.ClearSignals()
+	assert.Equal(t, "X64{Inf, +}", ctx.FromBigInt(big.NewInt(1), 400).Debug())
+	assert.Equal(t, SignalOverflow|SignalInexact|SignalRounding, ctx.Signal())
+
+	ctx.ClearSignals()
+	assert.Equal(t, "X64{+, 1000, 369}", ctx.FromBigInt(big.NewInt(1), 372).Debug())
+	assert.Equal(t, SignalClear, ctx.Signal())
+
+	ctx.ClearSignals()
+	assert.Equal(t, "X64{sNaN, +}", ctx.FromBigInt(nil, 0).Debug())
+	assert.Equal(t, SignalInvalidOperation, ctx.Signal())
+}
+
+func TestContext64FromRat(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *big.Rat
+		precision Precision
+		rounding  Rounding
+		expected  string
+		signals   Signal
+	}{
+		{"Exact", big.NewRat(1, 8), PrecisionMaximum64, RoundTiesToEven, "X64{+, 125, -3}", 0},
+		{"Integer", big.NewRat(-42, 1), PrecisionMaximum64, RoundTiesToEven, "X64{-, 42, 0}", 0},
+		{"Third", big.NewRat(1, 3), PrecisionMaximum64, RoundTiesToEven, "X64{+, 3333333333333333, -16}", SignalInexact | SignalRounding},
+		{"TwoThirds", big.NewRat(-2, 3), PrecisionDefault64, RoundTiesToEven, "X64{-, 666666667, -9}", SignalInexact | SignalRounding},
+		{"TwoThirdsTowardZero", big.NewRat(-2, 3), PrecisionDefault64, RoundTowardZero, "X64{-, 666666666, -9}", SignalInexact | SignalRounding},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := NewContext64(tt.precision, tt.rounding, BasicTraps, DefaultLocale)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, ctx.FromRat(tt.input).Debug())
+			assert.Equal(t, tt.signals, ctx.Signal())
+		})
+	}
+}
+
+func TestContext64FromRatSubnormal(t *testing.T) {
+	ctx, err := NewContext64(PrecisionMaximum64, RoundTiesToEven, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	tiny := new(big.Rat).SetFrac(big.NewInt(2), new(big.Int).Exp(big.NewInt(10), big.NewInt(399), nil))
+	assert.Equal(t, "X64{+, 0, -398}", ctx.FromRat(tiny).Debug())
+	assert.Equal(t, SignalUnderflow|SignalInexact|SignalRounding, ctx.Signal())
+
+	ctx.ClearSignals()
+	tiny.SetFrac(big.NewInt(123), new(big.Int).Exp(big.NewInt(10), big.NewInt(399), nil))
+	assert.Equal(t, "X64{+, 12, -398}", ctx.FromRat(tiny).Debug())
+	assert.Equal(t, SignalUnderflow|SignalInexact|SignalRounding, ctx.Signal())
+}
+
+func TestContext64FromBigFloat(t *testing.T) {
+	ctx, err := NewContext64(PrecisionMaximum64, RoundTiesToEven, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	assert.Equal(t, "X64{+, 15, -1}", ctx.FromBigFloat(big.NewFloat(1.5)).Debug())
+	assert.Equal(t, "X64{-, 0, 0}", ctx.FromBigFloat(big.NewFloat(math.Copysign(0, -1))).Debug())
+	assert.Equal(t, "X64{Inf, -}", ctx.FromBigFloat(big.NewFloat(math.Inf(-1))).Debug())
+	assert.Equal(t, SignalClear, ctx.Signal())
+
+	assert.Equal(t, "X64{+, 1000000000000000, -16}", ctx.FromBigFloat(big.NewFloat(0.1)).Debug())
+	assert.Equal(t, SignalInexact|SignalRounding, ctx.Signal())
+
+	ctx.ClearSignals()
+	huge := new(big.Float).SetMantExp(big.NewFloat(1), 5000)
+	assert.Equal(t, "X64{Inf, +}", ctx.FromBigFloat(huge).Debug())
+	assert.Equal(t, SignalOverflow|SignalInexact|SignalRounding, ctx.Signal())
+}
+
+func TestX64BigConversions(t *testing.T) {
+	var x X64
+	require.NoError(t, x.pack(kind_finite, signc_negative, -3, 1234567))
+
+	coe, exp, err := x.BigInt()
+	require.NoError(t, err)
+	assert.Equal(t, "-1234567", coe.String())
+	assert.Equal(t, -3, exp)
+
+	r, err := x.Rat()
+	require.NoError(t, err)
+	assert.Equal(t, "-1234567/1000", r.String())
+
+	f, err := x.BigFloat(0)
+	require.NoError(t, err)
+	assert.Equal(t, "-1234.567", f.Text('f', 3))
+	assert.Equal(t, big.Above, f.Acc())
+
+	require.NoError(t, x.pack(kind_finite, signc_positive, 2, 5))
+	f, err = x.BigFloat(0)
+	require.NoError(t, err)
+	assert.Equal(t, "500", f.Text('f', 0))
+	assert.Equal(t, big.Exact, f.Acc())
+
+	require.NoError(t, x.pack(kind_infinity, signc_negative, 0, 0))
+	_, err = x.Rat()
+	assert.ErrorIs(t, err, ErrNotFinite)
+	f, err = x.BigFloat(0)
+	require.NoError(t, err)
+	assert.True(t, f.IsInf())
+
+	require.NoError(t, x.pack(kind_quiet, signc_positive, 0, 0))
+	_, _, err = x.BigInt()
+	assert.ErrorIs(t, err, ErrNotFinite)
+	_, err = x.BigFloat(0)
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestX32BigConversions(t *testing.T) {
+	ctx, err := NewContext32(PrecisionMaximum32, RoundTiesToEven, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	x := ctx.FromRat(big.NewRat(-7, 4))
+	assert.Equal(t, "X32{-, 175, -2}", x.Debug())
+
+	r, err := x.Rat()
+	require.NoError(t, err)
+	assert.Equal(t, "-7/4", r.String())
+	assert.Equal(t, int64(-2), ctx.ToInt64(x))
+	assert.Equal(t, SignalInexact|SignalRounding, ctx.Signal())
+}
+
+func TestContext64FromFloat64(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     float64
+		precision Precision
+		expected  string
+		signals   Signal
+	}{
+		{"Tenth", 0.1, PrecisionMaximum64, "X64{+, 1, -1}", 0},
+		{"Negative", -123.456, PrecisionMaximum64, "X64{-, 123456, -3}", 0},
+		{"NegativeZero", math.Copysign(0, -1), PrecisionMaximum64, "X64{-, 0, 0}", 0},
+		{"Integer", 1e23, PrecisionMaximum64, "X64{+, 1, 23}", 0},
+		{"Third", 1.0 / 3, PrecisionMaximum64, "X64{+, 3333333333333333, -16}", 0},
+		{"SeventeenDigits", 0.30000000000000004, PrecisionMaximum64, "X64{+, 3000000000000000, -16}", SignalInexact | SignalRounding},
+		{"Rounded", 2.0 / 3, PrecisionDefault64, "X64{+, 666666667, -9}", SignalInexact | SignalRounding},
+		{"MaxFloat", math.MaxFloat64, PrecisionMaximum64, "X64{+, 1797693134862316, 293}", SignalInexact | SignalRounding},
+		{"SmallestSubnormal", 5e-324, PrecisionMaximum64, "X64{+, 5, -324}", 0},
+		{"NaN", math.NaN(), PrecisionMaximum64, "X64{qNaN, +}", 0},
+		{"Infinity", math.Inf(-1), PrecisionMaximum64, "X64{Inf, -}", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := NewContext64(tt.precision, RoundTiesToEven, BasicTraps, DefaultLocale)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, ctx.FromFloat64(tt.input).Debug())
+			assert.Equal(t, tt.signals, ctx.Signal())
+		})
+	}
+}
+
+func TestContext64FromFloat64Exact(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float64
+		expected string
+		signals  Signal
+	}{
+		{"Half", 0.5, "X64{+, 5, -1}", 0},
+		{"Integer", 1024, "X64{+, 1024, 0}", 0},
+		{"Tenth", 0.1, "X64{+, 1000000000000000, -16}", SignalInexact | SignalRounding},
+		{"NegativeThird", -1.0 / 3, "X64{-, 3333333333333333, -16}", SignalInexact | SignalRounding},
+		{"TwoToSixty", 0x1p60, "X64{+, 1152921504606847, 3}", SignalInexact | SignalRounding},
+		{"NegativeZero", math.Copysign(0, -1), "X64{-, 0, 0}", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := NewContext64(PrecisionMaximum64, RoundTiesToEven, BasicTraps, DefaultLocale)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, ctx.FromFloat64Exact(tt.input).Debug())
+			assert.Equal(t, tt.signals, ctx.Signal())
+		})
+	}
+}
+
+func TestContext64ToFloat64(t *testing.T) {
+	tests := []struct {
+		name     string
+		sign     signc
+		exp      int16
+		coe      uint64
+		expected float64
+		signals  Signal
+	}{
+		{"Tenth", signc_positive, -1, 1, 0.1, SignalInexact | SignalRounding},
+		{"Half", signc_negative, -1, 5, -0.5, 0},
+		{"Integer", signc_positive, 3, 12345, 12345000, 0},
+		{"LargeExact", signc_positive, 20, 1, 1e20, 0},
+		{"LargeInexact", signc_positive, 19, 1234567, 1234567e19, SignalInexact | SignalRounding},
+		{"BeyondFastPath", signc_positive, 22, 1234567, 1234567e22, SignalInexact | SignalRounding},
+		{"SlowPath", signc_positive, -30, 9999999999999999, 9999999999999999e-30, SignalInexact | SignalRounding},
+		{"NegativeZero", signc_negative, 5, 0, math.Copysign(0, -1), 0},
+		{"Overflow", signc_negative, 369, 1, math.Inf(-1), SignalOverflow | SignalInexact | SignalRounding},
+		{"Underflow", signc_positive, -398, 1, 0, SignalUnderflow | SignalInexact | SignalRounding},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := BasicContext64()
+
+			var x X64
+			require.NoError(t, x.pack(kind_finite, tt.sign, tt.exp, tt.coe))
+
+			got := ctx.ToFloat64(x)
+			assert.Equal(t, math.Float64bits(tt.expected), math.Float64bits(got), "got %v, want %v", got, tt.expected)
+			assert.Equal(t, tt.signals, ctx.Signal())
+		})
+	}
+}
+
+func TestFloat64RoundTrip(t *testing.T) {
+	ctx, err := NewContext64(PrecisionMaximum64, RoundTiesToEven, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	for _, f := range []float64{0.1, 1.5, -2.75, 123.456, 1e-300, 6.02214076e23, math.Pi, 1234567890123456} {
+		assert.Equal(t, f, ctx.ToFloat64(ctx.FromFloat64(f)))
+	}
+}
+
+func TestContext32FromFloat64(t *testing.T) {
+	ctx := BasicContext32()
+
+	assert.Equal(t, "X32{+, 31416, -4}", ctx.FromFloat64(math.Pi).Debug())
+	assert.Equal(t, SignalInexact|SignalRounding, ctx.Signal())
+	assert.Equal(t, 3.1416, ctx.ToFloat64(ctx.FromFloat64(math.Pi)))
+}
+
+func TestNilContextConversions(t *testing.T) {
+	var c64 *Context64
+	x := MustParse64("-42.5")
+	assert.Equal(t, int64(-42), c64.ToInt64(x))
+	assert.Equal(t, uint64(0), c64.ToUint64(x))
+	assert.Equal(t, -42.5, c64.ToFloat64(x))
+
+	var c32 *Context32
+	y := MustParse32("17.25")
+	assert.Equal(t, int64(17), c32.ToInt64(y))
+	assert.Equal(t, uint64(17), c32.ToUint64(y))
+	assert.Equal(t, 17.25, c32.ToFloat64(y))
+}
@@ -0,0 +1,183 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"slices"
+)
+
+// Forms of a decimal exchanged through Decompose and Compose,
+// as used by database/sql drivers that support decimal composition.
+const (
+	FormFinite   byte = 0 // Finite number
+	FormInfinite byte = 1 // Positive or negative infinity
+	FormNaN      byte = 2 // NaN
+)
+
+// ErrInexact is returned when a value cannot be represented exactly.
+var ErrInexact = fmt.Errorf("value cannot be represented exactly")
+
+// Decompose returns the internal decimal state of x in parts, as used by
+// database drivers. The coefficient is a big-endian unsigned integer; when buf
+// has sufficient capacity it is used to hold the coefficient.
+func (x X64) Decompose(buf []byte) (form byte, negative bool, coefficient []byte, exponent int32) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return FormNaN, false, nil, 0
+	}
+
+	return decompose(buf, k, sign, int(exp), coe)
+}
+
+// Compose sets x from the parts produced by Decompose. It returns an error
+// wrapping ErrInexact when the value cannot be represented exactly, and
+// ErrOutOfRange when the form is unknown.
+func (x *X64) Compose(form byte, negative bool, coefficient []byte, exponent int32) error {
+	if x == nil {
+		return newInternalError(nil, "nil receiver")
+	}
+
+	ctx, err := NewContext64(PrecisionMaximum64, BasicRounding, BasicTraps, DefaultLocale)
+	if err != nil {
+		return err
+	}
+
+	res := ctx.Compose(form, negative, coefficient, exponent)
+	if err := composeError(ctx.signals); err != nil {
+		return err
+	}
+
+	*x = res
+	return nil
+}
+
+// Decompose returns the internal decimal state of x in parts, as used by
+// database drivers. See X64.Decompose for details.
+func (x X32) Decompose(buf []byte) (form byte, negative bool, coefficient []byte, exponent int32) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return FormNaN, false, nil, 0
+	}
+
+	return decompose(buf, k, sign, int(exp), uint64(coe))
+}
+
+// Compose sets x from the parts produced by Decompose.
+// See X64.Compose for details.
+func (x *X32) Compose(form byte, negative bool, coefficient []byte, exponent int32) error {
+	if x == nil {
+		return newInternalError(nil, "nil receiver")
+	}
+
+	ctx, err := NewContext32(PrecisionMaximum32, BasicRounding, BasicTraps, DefaultLocale)
+	if err != nil {
+		return err
+	}
+
+	res := ctx.Compose(form, negative, coefficient, exponent)
+	if err := composeError(ctx.signals); err != nil {
+		return err
+	}
+
+	*x = res
+	return nil
+}
+
+// Compose converts the parts produced by a Decompose method into an X64,
+// rounding to the context precision when necessary. An unknown form raises
+// SignalInvalidOperation and returns a signaling NaN.
+func (ctx *Context64) Compose(form byte, negative bool, coefficient []byte, exponent int32) X64 {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	sign := signOf(negative)
+	switch form {
+	case FormFinite:
+		coe, exp, sticky := splitCoefficient(coefficient, int(exponent))
+		return ctx.finish(sign, coe, exp, sticky)
+	case FormInfinite:
+		return newSpecial64(sign, kind_infinity)
+	case FormNaN:
+		return newSpecial64(sign, kind_quiet)
+	default:
+		ctx.signals |= SignalInvalidOperation
+		return newSpecial64(signc_positive, kind_signaling)
+	}
+}
+
+// Compose converts the parts produced by a Decompose method into an X32,
+// rounding to the context precision when necessary.
+// See Context64.Compose for details.
+func (ctx *Context32) Compose(form byte, negative bool, coefficient []byte, exponent int32) X32 {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	sign := signOf(negative)
+	switch form {
+	case FormFinite:
+		coe, exp, sticky := splitCoefficient(coefficient, int(exponent))
+		return ctx.finish(sign, coe, exp, sticky)
+	case FormInfinite:
+		return newSpecial32(sign, kind_infinity)
+	case FormNaN:
+		return newSpecial32(sign, kind_quiet)
+	default:
+		ctx.signals |= SignalInvalidOperation
+		return newSpecial32(signc_positive, kind_signaling)
+	}
+}
+
+func decompose(buf []byte, k kind, sign signc, exp int, coe uint64) (byte, bool, []byte, int32) {
+	negative := sign == signc_negative
+	switch k {
+	case kind_infinity:
+		return FormInfinite, negative, nil, 0
+	case kind_quiet, kind_signaling:
+		return FormNaN, negative, nil, 0
+	}
+
+	// Emit the coefficient without leading zero bytes.
+	n := (bits.Len64(coe) + 7) / 8
+	buf = slices.Grow(buf[:0], n)
+	for i := n - 1; i >= 0; i-- {
+		buf = append(buf, byte(coe>>(8*i)))
+	}
+
+	return FormFinite, negative, buf, int32(exp)
+}
+
+// splitCoefficient reduces a big-endian coefficient and exponent to a
+// coefficient of at most 19 digits, reporting whether nonzero digits were
+// discarded.
+func splitCoefficient(coefficient []byte, exp int) (uint64, int, bool) {
+	// Skip leading zero bytes so short coefficients avoid big.Int.
+	for len(coefficient) > 0 && coefficient[0] == 0 {
+		coefficient = coefficient[1:]
+	}
+
+	if len(coefficient) <= 8 {
+		var coe uint64
+		for _, b := range coefficient {
+			coe = coe<<8 | uint64(b)
+		}
+		return coe, exp, false
+	}
+
+	_, coe, exp, sticky := splitBigInt(new(big.Int).SetBytes(coefficient), exp)
+	return coe, exp, sticky
+}
+
+// composeError maps the signals raised while composing to an error.
+func composeError(signals Signal) error {
+	switch {
+	case signals&SignalInvalidOperation != 0:
+		return fmt.Errorf("unknown decimal form: %w", ErrOutOfRange)
+	case signals&(SignalOverflow|SignalUnderflow|SignalInexact) != 0:
+		return fmt.Errorf("%w: %s", ErrInexact, signals)
+	default:
+		return nil
+	}
+}
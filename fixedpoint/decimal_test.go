@@ -0,0 +1,109 @@
+package fixedpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decimalDecompose and decimalCompose mirror the interfaces database/sql
+// drivers use to exchange decimal values.
+type decimalDecompose interface {
+	Decompose(buf []byte) (form byte, negative bool, coefficient []byte, exponent int32)
+}
+
+type decimalCompose interface {
+	Compose(form byte, negative bool, coefficient []byte, exponent int32) error
+}
+
+var (
+	_ decimalDecompose = X64{}
+	_ decimalDecompose = X32{}
+	_ decimalCompose   = (*X64)(nil)
+	_ decimalCompose   = (*X32)(nil)
+)
+
+func TestX64Decompose(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    X64
+		form     byte
+		negative bool
+		coe      []byte
+		exp      int32
+	}{
+		{"Finite", MustParse64("-1234.5"), FormFinite, true, []byte{0x30, 0x39}, -1},
+		{"Large", MustParse64("9999999999999999"), FormFinite, false, []byte{0x23, 0x86, 0xf2, 0x6f, 0xc0, 0xff, 0xff}, 0},
+		{"Zero", MustParse64("0.00"), FormFinite, false, []byte{}, -2},
+		{"Infinity", Inf64(-1), FormInfinite, true, nil, 0},
+		{"NaN", NaN64(), FormNaN, false, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form, negative, coe, exp := tt.input.Decompose(make([]byte, 0, 16))
+			assert.Equal(t, tt.form, form)
+			assert.Equal(t, tt.negative, negative)
+			assert.Equal(t, tt.coe, coe)
+			assert.Equal(t, tt.exp, exp)
+
+			var x X64
+			require.NoError(t, x.Compose(form, negative, coe, exp))
+			assert.Equal(t, tt.input, x)
+		})
+	}
+}
+
+func TestX64Compose(t *testing.T) {
+	var x X64
+	require.NoError(t, x.Compose(FormFinite, false, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0x30, 0x39}, -2))
+	assert.Equal(t, "123.45", x.String())
+
+	// 2^72 has 22 digits and cannot be held exactly.
+	err := x.Compose(FormFinite, false, []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0)
+	assert.ErrorIs(t, err, ErrInexact)
+	assert.Equal(t, "123.45", x.String())
+
+	err = x.Compose(FormFinite, true, []byte{1}, 400)
+	assert.ErrorIs(t, err, ErrInexact)
+
+	err = x.Compose(7, false, nil, 0)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+}
+
+func TestContext64Compose(t *testing.T) {
+	ctx := BasicContext64()
+
+	// 2^72 = 4722366482869645213696
+	x := ctx.Compose(FormFinite, true, []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0}, -3)
+	assert.Equal(t, "X64{-, 472236648, 10}", x.Debug())
+	assert.Equal(t, SignalInexact|SignalRounding, ctx.Signal())
+
+	ctx.ClearSignals()
+	x = ctx.Compose(FormNaN, true, nil, 0)
+	assert.Equal(t, "X64{qNaN, -}", x.Debug())
+	assert.Equal(t, SignalClear, ctx.Signal())
+
+	x = ctx.Compose(9, false, nil, 0)
+	assert.Equal(t, "X64{sNaN, +}", x.Debug())
+	assert.Equal(t, SignalInvalidOperation, ctx.Signal())
+}
+
+func TestX32DecomposeCompose(t *testing.T) {
+	in := MustParse32("-98.76543")
+	form, negative, coe, exp := in.Decompose(nil)
+	assert.Equal(t, FormFinite, form)
+	assert.True(t, negative)
+	assert.Equal(t, int32(-5), exp)
+
+	var x X32
+	require.NoError(t, x.Compose(form, negative, coe, exp))
+	assert.Equal(t, in, x)
+
+	err := x.Compose(FormFinite, false, []byte{0x98, 0x96, 0x81}, 0) // 10000001
+	assert.ErrorIs(t, err, ErrInexact)
+
+	ctx := BasicContext32()
+	assert.Equal(t, "X32{+, 10000, 3}", ctx.Compose(FormFinite, false, []byte{0x98, 0x96, 0x81}, 0).Debug())
+}
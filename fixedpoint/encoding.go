@@ -0,0 +1,295 @@
+package fixedpoint
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+var (
+	_ encoding.TextMarshaler   = X64{}
+	_ encoding.TextAppender    = X64{}
+	_ encoding.TextUnmarshaler = (*X64)(nil)
+	_ json.Marshaler           = X64{}
+	_ json.Unmarshaler         = (*X64)(nil)
+	_ encoding.TextMarshaler   = X32{}
+	_ encoding.TextAppender    = X32{}
+	_ encoding.TextUnmarshaler = (*X32)(nil)
+	_ json.Marshaler           = X32{}
+	_ json.Unmarshaler         = (*X32)(nil)
+)
+
+// NonFinitePolicy selects how NaN and infinities are handled in JSON.
+type NonFinitePolicy uint8
+
+const (
+	// NonFiniteString encodes NaN and infinities as the JSON strings "NaN",
+	// "Infinity" and "-Infinity", and accepts those strings when decoding.
+	NonFiniteString NonFinitePolicy = iota
+
+	// NonFiniteNull encodes NaN and infinities as null. Decoding accepts
+	// them as strings, like NonFiniteString.
+	NonFiniteNull
+
+	// NonFiniteError fails to encode NaN and infinities and rejects them
+	// when decoding.
+	NonFiniteError
+)
+
+// JSONOptions controls how a JSONValue is encoded to and decoded from JSON.
+// The zero value matches the MarshalJSON and UnmarshalJSON methods of X64
+// and X32.
+type JSONOptions struct {
+	// Number emits finite values as bare JSON numbers instead of strings.
+	// Decoding always accepts both.
+	Number bool
+
+	// NonFinite selects how NaN and infinities are handled.
+	NonFinite NonFinitePolicy
+}
+
+// JSONValue pairs a value with the options used to encode and decode it, so
+// the encoding can vary per field or per call:
+//
+//	type Invoice struct {
+//		Total fixedpoint.JSONValue[fixedpoint.X64] `json:"total"`
+//	}
+//
+//	inv := Invoice{Total: fixedpoint.JSONValue[fixedpoint.X64]{X: total, Options: fixedpoint.JSONOptions{Number: true}}}
+//
+// Set Options, and Context if the values should not be parsed with
+// DefaultContext64 or DefaultContext32, before decoding into a JSONValue.
+type JSONValue[X X64 | X32] struct {
+	X       X
+	Options JSONOptions
+
+	// Context parses decoded values in place of DefaultContext64 or
+	// DefaultContext32. It must be a *Context64 for X64 or a *Context32 for
+	// X32. Decoding works on a clone, so Context collects no signals.
+	Context Context[X]
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding v.X with
+// v.Options.
+func (v JSONValue[X]) MarshalJSON() ([]byte, error) {
+	switch x := any(v.X).(type) {
+	case X64:
+		return x.marshalJSON(v.Options)
+	case X32:
+		return x.marshalJSON(v.Options)
+	}
+	panic("unreachable")
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, decoding into
+// v.X with v.Options.
+func (v *JSONValue[X]) UnmarshalJSON(data []byte) error {
+	switch x := any(&v.X).(type) {
+	case *X64:
+		ctx, ok := any(v.Context).(*Context64)
+		if !ok && v.Context != nil {
+			return fmt.Errorf("fixedpoint: cannot unmarshal into X64 with context %T", v.Context)
+		}
+		return x.unmarshalJSON(data, v.Options, ctx)
+	case *X32:
+		ctx, ok := any(v.Context).(*Context32)
+		if !ok && v.Context != nil {
+			return fmt.Errorf("fixedpoint: cannot unmarshal into X32 with context %T", v.Context)
+		}
+		return x.unmarshalJSON(data, v.Options, ctx)
+	}
+	panic("unreachable")
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The result is the ToSciString form of x, which parses back to exactly x.
+func (x X64) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, parsing
+// text with a clone of DefaultContext64. Syntax errors and trapped signals
+// are reported as errors, in which case x is left unchanged. To decode with
+// another context, use Context64.ParseChecked, or a JSONValue with Context
+// set for JSON.
+func (x *X64) UnmarshalText(text []byte) error {
+	if x == nil {
+		return newInternalError(nil, "nil receiver")
+	}
+
+	ctx := defaultContext64()
+	res := ctx.Parse(string(text))
+	if err := unmarshalError("X64", text, &ctx.context); err != nil {
+		return err
+	}
+
+	*x = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. Values are encoded as
+// strings in their ToSciString form. Use JSONValue for other encodings.
+func (x X64) MarshalJSON() ([]byte, error) {
+	return x.marshalJSON(JSONOptions{})
+}
+
+func (x X64) marshalJSON(opts JSONOptions) ([]byte, error) {
+	k, _, _, _, err := x.unpack()
+	if err != nil {
+		return nil, err
+	}
+
+	return opts.marshal(k, x.ToSciString())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts JSON
+// strings and bare numbers, which are parsed directly as decimals without
+// passing through float64, with a clone of DefaultContext64. A JSON null
+// leaves x unchanged. Use JSONValue to decode with another context.
+func (x *X64) UnmarshalJSON(data []byte) error {
+	return x.unmarshalJSON(data, JSONOptions{}, nil)
+}
+
+func (x *X64) unmarshalJSON(data []byte, opts JSONOptions, ctx *Context64) error {
+	if x == nil {
+		return newInternalError(nil, "nil receiver")
+	}
+
+	text, quoted, err := unquoteJSON(data)
+	if err != nil || text == nil {
+		return err
+	}
+
+	ctx = cleanContext64(ctx)
+	res := ctx.Parse(string(text))
+	if err := unmarshalError("X64", data, &ctx.context); err != nil {
+		return err
+	}
+
+	if k, _, _, _, _ := res.unpack(); k != kind_finite && !opts.acceptNonFinite(quoted) {
+		return fmt.Errorf("fixedpoint: cannot unmarshal %s into X64: %w", data, ErrNotFinite)
+	}
+
+	*x = res
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The result is the ToSciString form of x, which parses back to exactly x.
+func (x X32) MarshalText() ([]byte, error) {
+	return x.AppendText(nil)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, parsing
+// text with a clone of DefaultContext32. See X64.UnmarshalText for details.
+func (x *X32) UnmarshalText(text []byte) error {
+	if x == nil {
+		return newInternalError(nil, "nil receiver")
+	}
+
+	ctx := defaultContext32()
+	res := ctx.Parse(string(text))
+	if err := unmarshalError("X32", text, &ctx.context); err != nil {
+		return err
+	}
+
+	*x = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// See X64.MarshalJSON for details.
+func (x X32) MarshalJSON() ([]byte, error) {
+	return x.marshalJSON(JSONOptions{})
+}
+
+func (x X32) marshalJSON(opts JSONOptions) ([]byte, error) {
+	k, _, _, _, err := x.unpack()
+	if err != nil {
+		return nil, err
+	}
+
+	return opts.marshal(k, x.ToSciString())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// See X64.UnmarshalJSON for details.
+func (x *X32) UnmarshalJSON(data []byte) error {
+	return x.unmarshalJSON(data, JSONOptions{}, nil)
+}
+
+func (x *X32) unmarshalJSON(data []byte, opts JSONOptions, ctx *Context32) error {
+	if x == nil {
+		return newInternalError(nil, "nil receiver")
+	}
+
+	text, quoted, err := unquoteJSON(data)
+	if err != nil || text == nil {
+		return err
+	}
+
+	ctx = cleanContext32(ctx)
+	res := ctx.Parse(string(text))
+	if err := unmarshalError("X32", data, &ctx.context); err != nil {
+		return err
+	}
+
+	if k, _, _, _, _ := res.unpack(); k != kind_finite && !opts.acceptNonFinite(quoted) {
+		return fmt.Errorf("fixedpoint: cannot unmarshal %s into X32: %w", data, ErrNotFinite)
+	}
+
+	*x = res
+	return nil
+}
+
+// marshal encodes the ToSciString form s of a value of kind k.
+func (opts JSONOptions) marshal(k kind, s string) ([]byte, error) {
+	if k != kind_finite {
+		switch opts.NonFinite {
+		case NonFiniteNull:
+			return []byte("null"), nil
+		case NonFiniteError:
+			return nil, fmt.Errorf("fixedpoint: cannot marshal %s to JSON: %w", s, ErrNotFinite)
+		}
+	} else if opts.Number {
+		return []byte(s), nil
+	}
+
+	return strconv.AppendQuote(nil, s), nil
+}
+
+// unquoteJSON returns the text of a JSON string or number, and whether it was
+// quoted. A JSON null yields nil text.
+func unquoteJSON(data []byte) ([]byte, bool, error) {
+	if string(data) == "null" {
+		return nil, false, nil
+	}
+
+	if len(data) == 0 || data[0] != '"' {
+		return data, false, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, true, err
+	}
+
+	return []byte(s), true, nil
+}
+
+// acceptNonFinite reports whether a decoded NaN or infinity is allowed.
+// Only quoted values can spell them in valid JSON.
+func (opts JSONOptions) acceptNonFinite(quoted bool) bool {
+	return quoted && opts.NonFinite != NonFiniteError
+}
+
+// unmarshalError reports conversion syntax errors and trapped signals
+// raised while decoding data into the named type.
+func unmarshalError(typ string, data []byte, ctx *context) error {
+	if ctx.signals&(ctx.traps|SignalConversionSyntax) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("fixedpoint: cannot unmarshal %q into %s: %s", data, typ, ctx.signals)
+}
@@ -0,0 +1,186 @@
+package fixedpoint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextMarshaling(t *testing.T) {
+	tests := []struct {
+		name  string
+		input X64
+		text  string
+		debug string
+	}{
+		{"Finite", MustParse64("-123.45"), "-123.45", "X64{-, 12345, -2}"},
+		{"Zero", Zero64(), "0", "X64{+, 0, 0}"},
+		{"Infinity", Inf64(-1), "-Infinity", "X64{Inf, -}"},
+		{"NaN", NaN64(), "NaN", "X64{qNaN, +}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.input.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, tt.text, string(text))
+
+			var x X64
+			require.NoError(t, x.UnmarshalText(text))
+			assert.Equal(t, tt.debug, x.Debug())
+
+			var y X32
+			require.NoError(t, y.UnmarshalText(text))
+			text, err = y.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, tt.text, string(text))
+		})
+	}
+
+	cohort, err := New64(false, 120, 1)
+	require.NoError(t, err)
+	text, err := cohort.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "1.20E+3", string(text))
+
+	var y X64
+	require.NoError(t, y.UnmarshalText(text))
+	assert.Equal(t, cohort, y)
+
+	x := One64()
+	assert.Error(t, x.UnmarshalText([]byte("12a")))
+	assert.Equal(t, "X64{+, 1, 0}", x.Debug())
+}
+
+func TestMarshalJSON(t *testing.T) {
+	type record struct {
+		Price X64  `json:"price"`
+		Qty   X32  `json:"qty"`
+		Fee   *X64 `json:"fee,omitempty"`
+	}
+	type wrapped struct {
+		Price JSONValue[X64] `json:"price"`
+		Qty   JSONValue[X32] `json:"qty"`
+		Fee   *X64           `json:"fee,omitempty"`
+	}
+
+	tests := []struct {
+		name     string
+		options  JSONOptions
+		input    record
+		expected string
+		err      error
+	}{
+		{"Strings", JSONOptions{}, record{MustParse64("12.50"), MustParse32("-3"), nil}, `{"price":"12.50","qty":"-3"}`, nil},
+		{"Numbers", JSONOptions{Number: true}, record{MustParse64("12.50"), MustParse32("-3"), nil}, `{"price":12.50,"qty":-3}`, nil},
+		{"InfinityString", JSONOptions{Number: true}, record{Inf64(1), NaN32(), nil}, `{"price":"Infinity","qty":"NaN"}`, nil},
+		{"InfinityNull", JSONOptions{NonFinite: NonFiniteNull}, record{Inf64(-1), Zero32(), nil}, `{"price":null,"qty":"0"}`, nil},
+		{"InfinityError", JSONOptions{NonFinite: NonFiniteError}, record{NaN64(), Zero32(), nil}, "", ErrNotFinite},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(wrapped{
+				Price: JSONValue[X64]{X: tt.input.Price, Options: tt.options},
+				Qty:   JSONValue[X32]{X: tt.input.Qty, Options: tt.options},
+				Fee:   tt.input.Fee,
+			})
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(data))
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   JSONOptions
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{"String", JSONOptions{}, `"12.50"`, "X64{+, 1250, -2}", false},
+		{"EscapedString", JSONOptions{}, `"\u0031.5"`, "X64{+, 15, -1}", false},
+		{"Number", JSONOptions{}, `-0.1250`, "X64{-, 1250, -4}", false},
+		{"Null", JSONOptions{}, `null`, "X64{+, 7, 0}", false},
+		{"InfinityString", JSONOptions{}, `"-Infinity"`, "X64{Inf, -}", false},
+		{"InfinityNull", JSONOptions{NonFinite: NonFiniteNull}, `"NaN"`, "X64{qNaN, +}", false},
+		{"InfinityRejected", JSONOptions{NonFinite: NonFiniteError}, `"Infinity"`, "", true},
+		{"Boolean", JSONOptions{}, `true`, "", true},
+		{"BadString", JSONOptions{}, `"1.2.3"`, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := JSONValue[X64]{X: MustParse64("7"), Options: tt.options}
+			err := json.Unmarshal([]byte(tt.input), &v)
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, "X64{+, 7, 0}", v.X.Debug())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, v.X.Debug())
+			assert.Equal(t, tt.options, v.Options)
+		})
+	}
+}
+
+func TestJSONDefaults(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Plain  X64            `json:"plain"`
+		Number JSONValue[X32] `json:"number"`
+		Inf    X64            `json:"inf"`
+	}{MustParse64("1.50"), JSONValue[X32]{X: MustParse32("-2.5"), Options: JSONOptions{Number: true}}, Inf64(-1)})
+	require.NoError(t, err)
+	assert.Equal(t, `{"plain":"1.50","number":-2.5,"inf":"-Infinity"}`, string(data))
+
+	var x X64
+	require.NoError(t, json.Unmarshal([]byte(`"NaN"`), &x))
+	assert.Equal(t, "X64{qNaN, +}", x.Debug())
+}
+
+func TestUnmarshalJSONContext(t *testing.T) {
+	ctx64, err := NewContext64(PrecisionMinimum, RoundTowardZero, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	var v struct {
+		Price JSONValue[X64] `json:"price"`
+		Qty   JSONValue[X32] `json:"qty"`
+	}
+	v.Price.Context = ctx64
+	require.NoError(t, json.Unmarshal([]byte(`{"price": "1.2345", "qty": 1.2345}`), &v))
+	assert.Equal(t, "X64{+, 123, -2}", v.Price.X.Debug())
+	assert.Equal(t, "X32{+, 12345, -4}", v.Qty.X.Debug())
+	assert.Equal(t, SignalClear, ctx64.Signal(), "context must not collect signals")
+
+	ctx32, err := NewContext32(PrecisionMinimum, RoundTowardZero, BasicTraps|SignalInexact, DefaultLocale)
+	require.NoError(t, err)
+	v.Qty.Context = ctx32
+	assert.Error(t, json.Unmarshal([]byte(`{"qty": 1.2345}`), &v))
+	assert.Equal(t, "X32{+, 12345, -4}", v.Qty.X.Debug())
+
+	var typed *Context64
+	w := JSONValue[X64]{Context: typed}
+	require.NoError(t, json.Unmarshal([]byte(`"1.2345"`), &w))
+	assert.Equal(t, "X64{+, 12345, -4}", w.X.Debug())
+}
+
+func TestUnmarshalJSONNumber(t *testing.T) {
+	var v struct {
+		Amount X32 `json:"amount"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"amount": -42.125}`), &v))
+	assert.Equal(t, "X32{-, 42125, -3}", v.Amount.Debug())
+
+	assert.Error(t, v.Amount.UnmarshalJSON([]byte("NaN")))
+}
@@ -2,6 +2,11 @@ package fixedpoint
 
 import "fmt"
 
+// ErrOutOfRange is returned when the coefficient or exponent passed to a
+// constructor cannot be encoded in the target format, and reported by
+// ParseError when a parsed value or NaN payload is too large for it.
+var ErrOutOfRange = fmt.Errorf("out of range")
+
 type internalError struct {
 	data any
 	msg  string
@@ -17,3 +22,29 @@ func newInternalError(data any, msg string) error {
 		msg:  msg,
 	}
 }
+
+// Reasons reported by ParseError.
+var (
+	ErrEmpty                 = fmt.Errorf("empty input")
+	ErrInvalidDigit          = fmt.Errorf("invalid digit")
+	ErrMissingDigits         = fmt.Errorf("missing digits")
+	ErrMisplacedSeparator    = fmt.Errorf("misplaced separator")
+	ErrMultipleDecimalPoints = fmt.Errorf("multiple decimal points")
+	ErrUnexpectedWhitespace  = fmt.Errorf("unexpected whitespace")
+	ErrUnbalancedParentheses = fmt.Errorf("unbalanced parentheses")
+)
+
+// ParseError records a failed conversion of a string to a decimal.
+type ParseError struct {
+	Input  string // The input being parsed.
+	Offset int    // The byte offset in Input where the problem was found.
+	Err    error  // The reason the conversion failed, such as ErrInvalidDigit.
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("fixedpoint: parsing %q: %s at offset %d", e.Input, e.Err, e.Offset)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -3,6 +3,7 @@
 package fixedpoint
 
 import (
+	"fmt"
 	"log"
 	"unsafe"
 )
@@ -26,6 +27,47 @@ const (
 	kind_finite                // Finite number
 )
 
+// Class identifies the kind of value held by an X64 or X32.
+type Class uint8
+
+const (
+	ClassSignalingNaN = Class(kind_signaling) // Signaling NaN
+	ClassQuietNaN     = Class(kind_quiet)     // Quiet NaN
+	ClassInfinite     = Class(kind_infinity)  // Positive or negative infinity
+	ClassFinite       = Class(kind_finite)    // Finite number, including zero
+)
+
+// String returns the string representation of the class.
+func (c Class) String() string {
+	switch c {
+	case ClassSignalingNaN:
+		return "ClassSignalingNaN"
+	case ClassQuietNaN:
+		return "ClassQuietNaN"
+	case ClassInfinite:
+		return "ClassInfinite"
+	case ClassFinite:
+		return "ClassFinite"
+	default:
+		return fmt.Sprintf("Class(%d)", uint8(c))
+	}
+}
+
+// limits describes the precision and exponent range of a decimal format
+// in the width-independent terms used by rounding and conversion.
+type limits struct {
+	precision Precision // Maximum number of significant digits.
+	eMax      int       // Largest adjusted exponent.
+	eMin      int       // Smallest adjusted exponent of a normal number.
+	eTiny     int       // Smallest exponent of the coefficient.
+	eTop      int       // Largest exponent of the coefficient.
+}
+
+var (
+	limits64 = limits{PrecisionMaximum64, int(eMax64), int(eMin64), int(eTiny64), int(eTop64)}
+	limits32 = limits{PrecisionMaximum32, int(eMax32), int(eMin32), int(eTiny32), int(eTop32)}
+)
+
 // packed is an internal interface for decimal floating-point types
 // following the IEEE 754-2008 standard with BID encoding.
 type packed[E int8 | int16, C uint32 | uint64] interface {
@@ -1,6 +1,7 @@
 package fixedpoint
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -46,7 +47,7 @@ func BenchmarkQuantize(b *testing.B) {
 
 func BenchmarkString(b *testing.B) {
 	var x X64
-	if err := x.pack(kind_finite, signc_positive, 0, 0); err != nil {
+	if err := x.pack(kind_finite, signc_negative, -4, 123456789012345); err != nil {
 		b.Fatalf("pack failed: %v", err)
 	}
 
@@ -55,6 +56,18 @@ func BenchmarkString(b *testing.B) {
 	}
 }
 
+func BenchmarkAppendFormat(b *testing.B) {
+	var x X64
+	if err := x.pack(kind_finite, signc_negative, -4, 123456789012345); err != nil {
+		b.Fatalf("pack failed: %v", err)
+	}
+
+	buf := make([]byte, 0, 64)
+	for b.Loop() {
+		buf = x.AppendFormat(buf[:0], 'f', 2)
+	}
+}
+
 func BenchmarkParse(b *testing.B) {
 	c := BasicContext64()
 
@@ -62,3 +75,25 @@ func BenchmarkParse(b *testing.B) {
 		_ = c.Parse("-1234567.890")
 	}
 }
+
+func BenchmarkParseBytes(b *testing.B) {
+	c := BasicContext64()
+	input := []byte("-1234567.890")
+
+	for b.Loop() {
+		_ = c.ParseBytes(input)
+	}
+}
+
+func BenchmarkReader(b *testing.B) {
+	input := strings.Repeat("-1234567.890 42 0.5e-3\n", 1000)
+
+	for b.Loop() {
+		r := NewReader64(strings.NewReader(input), nil)
+		for r.Scan() {
+		}
+		if r.Err() != nil {
+			b.Fatal(r.Err())
+		}
+	}
+}
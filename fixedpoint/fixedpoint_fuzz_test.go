@@ -0,0 +1,156 @@
+package fixedpoint
+
+import (
+	"testing"
+)
+
+// canonical64 returns the canonical encoding of x, in which non-canonical
+// coefficients and payloads are zero and unused bits are clear.
+func canonical64(t *testing.T, x X64) X64 {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		t.Fatalf("unpack failed: %v", err)
+	}
+
+	var c X64
+	if err := c.pack(k, sign, exp, coe); err != nil {
+		t.Fatalf("pack failed: %v", err)
+	}
+	return c
+}
+
+// canonical32 returns the canonical encoding of x. See canonical64.
+func canonical32(t *testing.T, x X32) X32 {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		t.Fatalf("unpack failed: %v", err)
+	}
+
+	var c X32
+	if err := c.pack(k, sign, exp, coe); err != nil {
+		t.Fatalf("pack failed: %v", err)
+	}
+	return c
+}
+
+func FuzzX64SciStringRoundTrip(f *testing.F) {
+	f.Add(uint64(0x31C0000000000001)) // 1
+	f.Add(uint64(0xB180000000003039)) // -123.45
+	f.Add(uint64(0x6C7386F26FC0FFFF)) // Large coefficient form
+	f.Add(uint64(0x0000000000000001)) // Smallest subnormal
+	f.Add(uint64(0x5FE0000000000000)) // Zero at the largest exponent
+	f.Add(uint64(0x7800000000000000)) // Infinity
+	f.Add(uint64(0xFC0000000000007B)) // -NaN123
+	f.Add(uint64(0x7E00000000000000)) // sNaN
+
+	f.Fuzz(func(t *testing.T, bits uint64) {
+		x := canonical64(t, FromBits64(bits))
+		s := x.ToSciString()
+
+		ctx, err := NewContext64(PrecisionMaximum64, BasicRounding, BasicTraps, DefaultLocale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx.SetParseOptions(ParseStrict)
+
+		y, err := ctx.ParseChecked(s)
+		if err != nil {
+			t.Fatalf("ParseChecked(%q) failed: %v", s, err)
+		}
+		if y != x {
+			t.Errorf("ParseChecked(%q) = %s, want %s", s, y.Debug(), x.Debug())
+		}
+		if ctx.signals&^SignalUnderflow != 0 {
+			t.Errorf("ParseChecked(%q) raised %s", s, ctx.signals)
+		}
+	})
+}
+
+func FuzzX32SciStringRoundTrip(f *testing.F) {
+	f.Add(uint32(0x32800001)) // 1
+	f.Add(uint32(0xB1803039)) // -123.45
+	f.Add(uint32(0x6CB8967F)) // Large coefficient form
+	f.Add(uint32(0x00000001)) // Smallest subnormal
+	f.Add(uint32(0x78000000)) // Infinity
+	f.Add(uint32(0x7E00007B)) // sNaN123
+
+	f.Fuzz(func(t *testing.T, bits uint32) {
+		x := canonical32(t, FromBits32(bits))
+		s := x.ToSciString()
+
+		ctx, err := NewContext32(PrecisionMaximum32, BasicRounding, BasicTraps, DefaultLocale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx.SetParseOptions(ParseStrict)
+
+		y, err := ctx.ParseChecked(s)
+		if err != nil {
+			t.Fatalf("ParseChecked(%q) failed: %v", s, err)
+		}
+		if y != x {
+			t.Errorf("ParseChecked(%q) = %s, want %s", s, y.Debug(), x.Debug())
+		}
+		if ctx.signals&^SignalUnderflow != 0 {
+			t.Errorf("ParseChecked(%q) raised %s", s, ctx.signals)
+		}
+	})
+}
+
+func FuzzX64StringRoundTrip(f *testing.F) {
+	f.Add(uint64(0x31C0000000000001)) // 1
+	f.Add(uint64(0x31E0000000000078)) // 1.20E+3
+	f.Add(uint64(0x3180000000000000)) // 0.00
+	f.Add(uint64(0x30E0000000000005)) // 5E-7
+	f.Add(uint64(0x3440000000000001)) // 1E+20
+	f.Add(uint64(0x0000000000000001)) // Smallest subnormal
+	f.Add(uint64(0x7800000000000000)) // Infinity
+	f.Add(uint64(0xFC0000000000007B)) // -NaN123
+	f.Add(uint64(0xFE00000000000005)) // -sNaN5
+
+	f.Fuzz(func(t *testing.T, bits uint64) {
+		x := canonical64(t, FromBits64(bits))
+		s := x.String()
+
+		ctx, err := NewContext64(PrecisionMaximum64, BasicRounding, BasicTraps, DefaultLocale)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		y, err := ctx.ParseChecked(s)
+		if err != nil {
+			t.Fatalf("ParseChecked(%q) failed: %v", s, err)
+		}
+		if y != x {
+			t.Errorf("ParseChecked(%q) = %s, want %s", s, y.Debug(), x.Debug())
+		}
+	})
+}
+
+func FuzzX32StringRoundTrip(f *testing.F) {
+	f.Add(uint32(0x32800001)) // 1
+	f.Add(uint32(0x33000078)) // 1.20E+3
+	f.Add(uint32(0x31800000)) // 0.00
+	f.Add(uint32(0x2F000005)) // 5E-7
+	f.Add(uint32(0x00000001)) // Smallest subnormal
+	f.Add(uint32(0x78000000)) // Infinity
+	f.Add(uint32(0xFE000005)) // -sNaN5
+
+	f.Fuzz(func(t *testing.T, bits uint32) {
+		x := canonical32(t, FromBits32(bits))
+		s := x.String()
+
+		ctx, err := NewContext32(PrecisionMaximum32, BasicRounding, BasicTraps, DefaultLocale)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		y, err := ctx.ParseChecked(s)
+		if err != nil {
+			t.Fatalf("ParseChecked(%q) failed: %v", s, err)
+		}
+		if y != x {
+			t.Errorf("ParseChecked(%q) = %s, want %s", s, y.Debug(), x.Debug())
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package fixedpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew64(t *testing.T) {
+	tests := []struct {
+		name      string
+		neg       bool
+		coe       uint64
+		exp       int
+		expected  string
+		expectErr bool
+	}{
+		{"Positive", false, 12345, -2, "123.45", false},
+		{"Negative", true, 12345, -2, "-123.45", false},
+		{"NegativeZero", true, 0, 0, "-0", false},
+		{"MaxCoefficient", false, 9999999999999999, 0, "9999999999999999", false},
+		{"CoefficientTooLarge", false, 10000000000000000, 0, "", true},
+		{"ExponentTooLarge", false, 1, 370, "", true},
+		{"ExponentTooSmall", false, 1, -399, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, err := New64(tt.neg, tt.coe, tt.exp)
+			if tt.expectErr {
+				assert.ErrorIs(t, err, ErrOutOfRange)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, x.String())
+
+			neg, coe, exp, class := x.Components()
+			assert.Equal(t, tt.neg, neg)
+			assert.Equal(t, tt.coe, coe)
+			assert.Equal(t, tt.exp, exp)
+			assert.Equal(t, ClassFinite, class)
+		})
+	}
+}
+
+func TestNew32(t *testing.T) {
+	x, err := New32(true, 9999999, 90)
+	require.NoError(t, err)
+
+	neg, coe, exp, class := x.Components()
+	assert.True(t, neg)
+	assert.Equal(t, uint32(9999999), coe)
+	assert.Equal(t, 90, exp)
+	assert.Equal(t, ClassFinite, class)
+
+	_, err = New32(false, 10000000, 0)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+
+	_, err = New32(false, 1, 91)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+}
+
+func TestSpecialConstructors(t *testing.T) {
+	tests := []struct {
+		name  string
+		x64   X64
+		x32   X32
+		neg   bool
+		class Class
+		str   string
+	}{
+		{"Zero", Zero64(), Zero32(), false, ClassFinite, "0"},
+		{"One", One64(), One32(), false, ClassFinite, "1"},
+		{"NaN", NaN64(), NaN32(), false, ClassQuietNaN, "NaN"},
+		{"PositiveInfinity", Inf64(1), Inf32(0), false, ClassInfinite, "Infinity"},
+		{"NegativeInfinity", Inf64(-1), Inf32(-5), true, ClassInfinite, "-Infinity"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			neg, _, exp, class := tt.x64.Components()
+			assert.Equal(t, tt.neg, neg)
+			assert.Equal(t, 0, exp)
+			assert.Equal(t, tt.class, class)
+			assert.Equal(t, tt.str, tt.x64.String())
+
+			neg, _, exp, class = tt.x32.Components()
+			assert.Equal(t, tt.neg, neg)
+			assert.Equal(t, 0, exp)
+			assert.Equal(t, tt.class, class)
+			assert.Equal(t, tt.str, tt.x32.String())
+		})
+	}
+}
+
+func TestClassString(t *testing.T) {
+	assert.Equal(t, "ClassSignalingNaN", ClassSignalingNaN.String())
+	assert.Equal(t, "ClassQuietNaN", ClassQuietNaN.String())
+	assert.Equal(t, "ClassInfinite", ClassInfinite.String())
+	assert.Equal(t, "ClassFinite", ClassFinite.String())
+	assert.Equal(t, "Class(9)", Class(9).String())
+}
+
+func TestMustParse(t *testing.T) {
+	assert.Equal(t, "X64{+, 1234567890123456, -4}", MustParse64("123456789012.3456").Debug())
+	assert.Equal(t, "X32{-, 1234567, -2}", MustParse32("-12345.67").Debug())
+
+	assert.Panics(t, func() { MustParse64("abc") })
+	assert.Panics(t, func() { MustParse32("1..2") })
+}
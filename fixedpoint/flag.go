@@ -0,0 +1,52 @@
+package fixedpoint
+
+import "flag"
+
+var (
+	_ flag.Value = (*X64)(nil)
+	_ flag.Value = (*X32)(nil)
+	_ flag.Value = (*Rounding)(nil)
+	_ flag.Value = (*Precision)(nil)
+	_ flag.Value = (*Signal)(nil)
+)
+
+// Set implements the flag.Value interface, parsing s as by UnmarshalText.
+func (x *X64) Set(s string) error {
+	return x.UnmarshalText([]byte(s))
+}
+
+// Set implements the flag.Value interface, parsing s as by UnmarshalText.
+func (x *X32) Set(s string) error {
+	return x.UnmarshalText([]byte(s))
+}
+
+// Set implements the flag.Value interface, parsing s as by UnmarshalText.
+func (r *Rounding) Set(s string) error {
+	return r.UnmarshalText([]byte(s))
+}
+
+// Set implements the flag.Value interface, parsing s as by UnmarshalText.
+func (p *Precision) Set(s string) error {
+	return p.UnmarshalText([]byte(s))
+}
+
+// Set implements the flag.Value interface, parsing s as by UnmarshalText.
+func (s *Signal) Set(text string) error {
+	return s.UnmarshalText([]byte(text))
+}
+
+// ContextFlags64 defines the -precision, -rounding and -traps flags in fs,
+// or in flag.CommandLine if fs is nil, and returns a Context64 that they
+// configure. The context starts as a clone of DefaultContext64, whose
+// settings are the flag defaults, and is updated as fs parses the flags.
+func ContextFlags64(fs *flag.FlagSet) *Context64 {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	ctx := defaultContext64()
+	fs.Var(&ctx.precision, "precision", "number of significant digits, from 3 to 16")
+	fs.Var(&ctx.rounding, "rounding", "rounding mode, such as RoundTiesToEven or TowardZero")
+	fs.Var(&ctx.traps, "traps", "signals that are errors, such as SignalOverflow|SignalInvalidOperation")
+	return ctx
+}
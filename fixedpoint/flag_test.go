@@ -0,0 +1,90 @@
+package fixedpoint
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlagValues(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	threshold := MustParse64("100")
+	var (
+		small     X32
+		rounding  = RoundTowardZero
+		precision = PrecisionDefault64
+		signals   Signal
+	)
+	fs.Var(&threshold, "threshold", "")
+	fs.Var(&small, "small", "")
+	fs.Var(&rounding, "rounding", "")
+	fs.Var(&precision, "precision", "")
+	fs.Var(&signals, "signals", "")
+
+	err := fs.Parse([]string{"-threshold", "1.25E+3", "-small=-0.5", "-rounding=TiesToAway", "-precision", "12", "-signals", "overflow|inexact"})
+	require.NoError(t, err)
+	assert.Equal(t, "1.25E+3", threshold.ToSciString())
+	assert.Equal(t, "-0.5", small.String())
+	assert.Equal(t, RoundTiesToAway, rounding)
+	assert.Equal(t, Precision(12), precision)
+	assert.Equal(t, SignalOverflow|SignalInexact, signals)
+
+	for _, args := range [][]string{
+		{"-threshold", "abc"},
+		{"-small", "1E+999"},
+		{"-rounding", "HalfUp"},
+		{"-precision", "20"},
+		{"-signals", "nothing"},
+	} {
+		assert.Error(t, fs.Parse(args), "%v", args)
+	}
+	assert.Equal(t, "1.25E+3", threshold.ToSciString())
+}
+
+func TestFlagTextVar(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+
+	var (
+		limit    X64
+		rounding Rounding
+		traps    Signal
+	)
+	fs.TextVar(&limit, "limit", MustParse64("9.99"), "")
+	fs.TextVar(&rounding, "rounding", RoundTiesToEven, "")
+	fs.TextVar(&traps, "traps", BasicTraps, "")
+
+	require.NoError(t, fs.Parse([]string{"-rounding", "ToN"}))
+	assert.Equal(t, "9.99", limit.String())
+	assert.Equal(t, RoundTowardNegative, rounding)
+	assert.Equal(t, BasicTraps, traps)
+}
+
+func TestContextFlags64(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var usage strings.Builder
+	fs.SetOutput(&usage)
+
+	ctx := ContextFlags64(fs)
+	assert.Equal(t, DefaultContext64.Precision(), ctx.Precision())
+	assert.Equal(t, DefaultContext64.Rounding(), ctx.Rounding())
+	assert.Equal(t, DefaultContext64.Traps(), ctx.Traps())
+
+	require.NoError(t, fs.Parse([]string{"-precision=7", "-rounding", "RoundTowardPositive", "-traps", "SignalInvalidOperation"}))
+	assert.Equal(t, Precision(7), ctx.Precision())
+	assert.Equal(t, RoundTowardPositive, ctx.Rounding())
+	assert.Equal(t, SignalInvalidOperation, ctx.Traps())
+	assert.Equal(t, PrecisionMaximum64, DefaultContext64.Precision())
+
+	assert.Equal(t, "X64{+, 1234568, 0}", ctx.Parse("1234567.2").Debug())
+
+	fs.PrintDefaults()
+	assert.Contains(t, usage.String(), "-precision value")
+	assert.Contains(t, usage.String(), "(default 16)")
+	assert.Contains(t, usage.String(), "(default SignalOverflow|SignalUnderflow|SignalInvalidOperation)")
+}
@@ -0,0 +1,418 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+var (
+	_ fmt.Formatter = X64{}
+	_ fmt.Formatter = X32{}
+)
+
+// Format implements the fmt.Formatter interface. The verbs are:
+//
+//	%v, %s  the String form; %#v gives Go syntax such as fixedpoint.MustParse64("1.5")
+//	%q      the String form, double-quoted
+//	%f, %F  fixed-point notation, such as 123.450
+//	%e, %E  scientific notation, such as 1.2345e+02
+//	%g, %G  %e for large or small exponents, %f otherwise
+//	%b, %o, %O, %x, %X, %d
+//	        the raw IEEE 754 BID encoding as an unsigned integer
+//
+// The precision of %f and %e is the number of digits after the decimal
+// point, and of %g the number of significant digits; by default all digits
+// of the coefficient are shown. Rounding is done in decimal using the
+// rounding mode of DefaultContext64. Width and the '+', ' ', '-' and '0'
+// flags behave as they do for floating-point values.
+func (x X64) Format(f fmt.State, verb rune) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		fmt.Fprintf(f, "%%!%c(X64{ERROR: %v})", verb, err)
+		return
+	}
+
+	v := formatted{k, sign, int(exp), coe, defaultRounding64()}
+	switch verb {
+	case 'b', 'o', 'O', 'x', 'X', 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), x.uint64)
+	case 'v':
+		if f.Flag('#') {
+			pad(f, []byte(`fixedpoint.MustParse64("`+x.ToSciString()+`")`), 0)
+			return
+		}
+		fallthrough
+	default:
+		v.format(f, verb, x.String(), "X64")
+	}
+}
+
+// Format implements the fmt.Formatter interface.
+// See X64.Format for the supported verbs and flags.
+func (x X32) Format(f fmt.State, verb rune) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		fmt.Fprintf(f, "%%!%c(X32{ERROR: %v})", verb, err)
+		return
+	}
+
+	v := formatted{k, sign, int(exp), uint64(coe), defaultRounding32()}
+	switch verb {
+	case 'b', 'o', 'O', 'x', 'X', 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), x.uint32)
+	case 'v':
+		if f.Flag('#') {
+			pad(f, []byte(`fixedpoint.MustParse32("`+x.ToSciString()+`")`), 0)
+			return
+		}
+		fallthrough
+	default:
+		v.format(f, verb, x.String(), "X32")
+	}
+}
+
+// formatted holds the components of a value being formatted.
+type formatted struct {
+	k        kind
+	sign     signc
+	exp      int
+	coe      uint64
+	rounding Rounding
+}
+
+// format writes the value for the string and floating-point verbs.
+func (v formatted) format(f fmt.State, verb rune, s, typ string) {
+	var buf [64]byte
+	switch verb {
+	case 'v', 's':
+		if p, ok := f.Precision(); ok && p < len(s) {
+			s = s[:p]
+		}
+
+		signLen := 0
+		switch {
+		case v.k != kind_finite:
+			signLen = -1
+		case v.sign == signc_negative:
+			signLen = 1
+		}
+		pad(f, append(buf[:0], s...), signLen)
+	case 'q':
+		pad(f, strconv.AppendQuote(buf[:0], s), 0)
+	case 'f', 'F', 'e', 'E', 'g', 'G':
+		prec, ok := f.Precision()
+		if !ok {
+			prec = -1
+		}
+
+		b, signLen := v.appendSign(buf[:0], f)
+		if v.k != kind_finite {
+			signLen = -1
+		}
+		pad(f, v.appendValue(b, byte(verb), prec, f.Flag('#')), signLen)
+	default:
+		fmt.Fprintf(f, "%%!%c(fixedpoint.%s=%s)", verb, typ, s)
+	}
+}
+
+// append appends the value formatted as described by X64.AppendFormat.
+func (v formatted) append(dst []byte, verb byte, prec int) []byte {
+	switch verb {
+	case 'v':
+		return appendToString(dst, v.k, v.sign, v.exp, v.coe, false)
+	case 'f', 'F', 'e', 'E', 'g', 'G':
+		if v.sign == signc_negative {
+			dst = append(dst, '-')
+		}
+		return v.appendValue(dst, verb, prec, false)
+	default:
+		return append(dst, '%', verb)
+	}
+}
+
+// appendValue appends the unsigned value for %f, %e or %g. A negative prec
+// selects the default precision, and sharp keeps trailing zeros for %g.
+func (v formatted) appendValue(dst []byte, verb byte, prec int, sharp bool) []byte {
+	switch v.k {
+	case kind_finite:
+		return v.appendNumber(dst, verb, prec, sharp)
+	case kind_infinity:
+		return append(dst, "Infinity"...)
+	default:
+		return append(dst, "NaN"...)
+	}
+}
+
+// appendError appends the representation of a value that cannot be unpacked.
+func appendError(dst []byte, typ string, err error) []byte {
+	return fmt.Appendf(dst, "%s{ERROR: %v}", typ, err)
+}
+
+// defaultRounding64 returns the rounding mode of DefaultContext64 without
+// cloning it.
+func defaultRounding64() Rounding {
+	if DefaultContext64 == nil {
+		return BasicRounding
+	}
+	return DefaultContext64.rounding
+}
+
+// defaultRounding32 returns the rounding mode of DefaultContext32 without
+// cloning it.
+func defaultRounding32() Rounding {
+	if DefaultContext32 == nil {
+		return BasicRounding
+	}
+	return DefaultContext32.rounding
+}
+
+// appendSign appends the sign selected by the value and the '+' and ' '
+// flags, and returns its length.
+func (v formatted) appendSign(dst []byte, f fmt.State) ([]byte, int) {
+	switch {
+	case v.sign == signc_negative:
+		return append(dst, '-'), 1
+	case f.Flag('+'):
+		return append(dst, '+'), 1
+	case f.Flag(' '):
+		return append(dst, ' '), 1
+	}
+	return dst, 0
+}
+
+// appendNumber appends the digits of a finite value for %f, %e or %g.
+func (v formatted) appendNumber(dst []byte, verb byte, prec int, sharp bool) []byte {
+	hasPrec := prec >= 0
+	coe, exp := v.coe, v.exp
+
+	switch verb {
+	case 'f', 'F':
+		if !hasPrec {
+			prec = max(-exp, 0)
+		}
+		coe, exp = v.round(coe, exp, -prec)
+		return appendFixed(dst, coe, exp, prec)
+
+	case 'e', 'E':
+		if !hasPrec {
+			prec = int(countDigits(coe)) - 1
+		}
+		coe, exp = v.roundDigits(coe, exp, prec+1)
+		return appendExponent(dst, coe, exp, prec, verb)
+
+	default:
+		if !hasPrec {
+			prec = int(countDigits(coe))
+		}
+		prec = max(prec, 1)
+		coe, exp = v.roundDigits(coe, exp, prec)
+		eprec := prec
+		if !hasPrec {
+			eprec = 6
+		}
+
+		// Drop insignificant trailing zeros unless the '#' flag is set.
+		if !sharp {
+			for coe != 0 && coe%10 == 0 {
+				coe, exp = coe/10, exp+1
+			}
+			prec = min(prec, int(countDigits(coe)))
+		}
+
+		// Use exponent notation as %g does for floating-point values.
+		digits := int(countDigits(coe))
+		adjusted := 0
+		if coe != 0 {
+			adjusted = exp + digits - 1
+		}
+		if eprec > digits && digits >= adjusted+1 {
+			eprec = digits
+		}
+		if adjusted < -4 || adjusted >= eprec {
+			return appendExponent(dst, coe, exp, prec-1, verb-'g'+'e')
+		}
+		return appendFixed(dst, coe, exp, max(prec-1-adjusted, 0))
+	}
+}
+
+// round rounds coe × 10^exp to a multiple of 10^target.
+func (v formatted) round(coe uint64, exp, target int) (uint64, int) {
+	if exp >= target {
+		return coe, exp
+	}
+
+	quotient, rem := shiftRight(coe, target-exp, false)
+	if roundUp(v.rounding, v.sign, quotient&1 == 1, rem) {
+		quotient++
+	}
+	return quotient, target
+}
+
+// roundDigits rounds coe × 10^exp to at most n significant digits.
+func (v formatted) roundDigits(coe uint64, exp, n int) (uint64, int) {
+	digits := int(countDigits(coe))
+	if digits <= n {
+		return coe, exp
+	}
+
+	coe, exp = v.round(coe, exp, exp+digits-n)
+	if int(countDigits(coe)) > n {
+		coe, exp = coe/10, exp+1
+	}
+	return coe, exp
+}
+
+// appendFixed appends coe × 10^exp with prec digits after the decimal point,
+// where exp >= -prec.
+func appendFixed(dst []byte, coe uint64, exp, prec int) []byte {
+	dst = appendInteger(dst, coe, exp, 1, digitStyle{zero: '0'})
+	if prec == 0 {
+		return dst
+	}
+
+	dst = append(dst, '.')
+	return appendFraction(dst, coe, exp, prec, '0')
+}
+
+// digitStyle selects the digits and grouping of appendInteger.
+type digitStyle struct {
+	zero      rune // Zero digit, such as '0' or DigitsDevanagari.
+	sep       rune // Grouping separator, or 0 for none.
+	primary   int  // Size of the group nearest the decimal point.
+	secondary int  // Size of the other groups, or 0 for primary.
+}
+
+// appendInteger appends the integer part of coe × 10^exp, padded with
+// leading zeros to at least minDigits digits and grouped as style says.
+// A zero coefficient has no integer digits whatever its exponent, so 0E+3
+// gives minDigits zeros rather than "0000".
+func appendInteger(dst []byte, coe uint64, exp, minDigits int, style digitStyle) []byte {
+	var buf [20]byte
+	digits := strconv.AppendUint(buf[:0], coe, 10)
+
+	point := 0
+	if coe != 0 {
+		point = len(digits) + exp
+	}
+
+	secondary := style.secondary
+	if secondary == 0 {
+		secondary = style.primary
+	}
+
+	n := max(point, minDigits)
+	for i := range n {
+		d := byte('0')
+		if j := i - n + point; 0 <= j && j < len(digits) {
+			d = digits[j]
+		}
+		dst = appendDigit(dst, d, style.zero)
+
+		if left := n - 1 - i; style.sep != 0 && style.primary > 0 && left > 0 && isGroupBoundary(left, style.primary, secondary) {
+			dst = utf8.AppendRune(dst, style.sep)
+		}
+	}
+	return dst
+}
+
+// appendFraction appends the fraction digits of coe × 10^exp, without the
+// decimal point, padded with trailing zeros to at least minDigits digits.
+func appendFraction(dst []byte, coe uint64, exp, minDigits int, zero rune) []byte {
+	var buf [20]byte
+	digits := strconv.AppendUint(buf[:0], coe, 10)
+
+	frac := max(-exp, 0)
+	for i := len(digits) - frac; i < len(digits); i++ {
+		d := byte('0')
+		if i >= 0 {
+			d = digits[i]
+		}
+		dst = appendDigit(dst, d, zero)
+	}
+	for i := frac; i < minDigits; i++ {
+		dst = appendDigit(dst, '0', zero)
+	}
+	return dst
+}
+
+// appendDigit appends the ASCII digit d in the digit system of zero.
+func appendDigit(dst []byte, d byte, zero rune) []byte {
+	if zero == '0' {
+		return append(dst, d)
+	}
+	return utf8.AppendRune(dst, zero+rune(d-'0'))
+}
+
+// appendExponent appends coe × 10^exp in scientific notation with prec
+// digits after the decimal point, where coe has at most prec+1 digits.
+func appendExponent(dst []byte, coe uint64, exp, prec int, e byte) []byte {
+	var buf [20]byte
+	digits := strconv.AppendUint(buf[:0], coe, 10)
+	adjusted := 0
+	if coe != 0 {
+		adjusted = exp + len(digits) - 1
+	}
+
+	dst = append(dst, digits[0])
+	if prec > 0 {
+		dst = append(dst, '.')
+		dst = append(dst, digits[1:]...)
+		dst = appendZeros(dst, prec-len(digits)+1)
+	}
+
+	dst = append(dst, e)
+	if adjusted < 0 {
+		dst = append(dst, '-')
+		adjusted = -adjusted
+	} else {
+		dst = append(dst, '+')
+	}
+	if adjusted < 10 {
+		dst = append(dst, '0')
+	}
+	return strconv.AppendInt(dst, int64(adjusted), 10)
+}
+
+func appendZeros(dst []byte, n int) []byte {
+	for ; n > 0; n-- {
+		dst = append(dst, '0')
+	}
+	return dst
+}
+
+// pad writes b to f, padded to the width of f. If signLen is not negative,
+// the '0' flag pads with zeros after the first signLen bytes.
+func pad(f fmt.State, b []byte, signLen int) {
+	width, ok := f.Width()
+	if !ok || len(b) >= width {
+		f.Write(b)
+		return
+	}
+
+	n := width - len(b)
+	switch {
+	case f.Flag('-'):
+		f.Write(b)
+		writeRepeat(f, ' ', n)
+	case f.Flag('0') && signLen >= 0:
+		f.Write(b[:signLen])
+		writeRepeat(f, '0', n)
+		f.Write(b[signLen:])
+	default:
+		writeRepeat(f, ' ', n)
+		f.Write(b)
+	}
+}
+
+func writeRepeat(f fmt.State, c byte, n int) {
+	var buf [16]byte
+	for i := range buf {
+		buf[i] = c
+	}
+	for n > 0 {
+		m := min(n, len(buf))
+		f.Write(buf[:m])
+		n -= m
+	}
+}
@@ -0,0 +1,148 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatVerbs(t *testing.T) {
+	tests := []struct {
+		format   string
+		value    string
+		expected string
+	}{
+		{"%v", "123.45", "123.45"},
+		{"%s", "-0.001", "-0.001"},
+		{"%q", "1.5", `"1.5"`},
+		{"%8v", "1.5", "     1.5"},
+		{"%-8s|", "1.5", "1.5     |"},
+		{"%08v", "-1.5", "-00001.5"},
+		{"%08s", "1.5", "000001.5"},
+		{"%08v", "-Infinity", "-Infinity"},
+		{"%06v", "NaN", "   NaN"},
+		{"%v", "NaN", "NaN"},
+		{"%v", "-sNaN12", "-sNaN12"},
+		{"%.2s", "123.45", "12"},
+		{"%#v", "-1.50", `fixedpoint.MustParse64("-1.50")`},
+		{"%#v", "1E+10", `fixedpoint.MustParse64("1E+10")`},
+		{"%#v", "NaN", `fixedpoint.MustParse64("NaN")`},
+
+		{"%f", "123.450", "123.450"},
+		{"%f", "1E+3", "1000"},
+		{"%f", "1.23E-5", "0.0000123"},
+		{"%.2f", "123.455", "123.46"},
+		{"%.2f", "123.445", "123.44"},
+		{"%.2f", "-0.005", "-0.00"},
+		{"%.0f", "2.5", "2"},
+		{"%.0f", "3.5", "4"},
+		{"%.3f", "1.5", "1.500"},
+		{"%.1f", "9.96", "10.0"},
+		{"%F", "-12.5", "-12.5"},
+		{"%f", "0E+3", "0"},
+		{"%.2f", "0E+3", "0.00"},
+		{"%.0f", "-0E+3", "-0"},
+		{"%f", "0E-2", "0.00"},
+
+		{"%e", "123.45", "1.2345e+02"},
+		{"%E", "0.000120", "1.20E-04"},
+		{"%e", "0", "0e+00"},
+		{"%.2e", "0E-5", "0.00e+00"},
+		{"%.2e", "9.995", "1.00e+01"},
+		{"%.3e", "1", "1.000e+00"},
+		{"%e", "1E+369", "1e+369"},
+		{"%.0e", "15", "2e+01"},
+
+		{"%g", "123.45", "123.45"},
+		{"%g", "1.20", "1.2"},
+		{"%#g", "1.20", "1.20"},
+		{"%g", "1E+10", "1e+10"},
+		{"%g", "1234567", "1.234567e+06"},
+		{"%g", "100000", "100000"},
+		{"%g", "0.0001", "0.0001"},
+		{"%g", "0.00001", "1e-05"},
+		{"%.3g", "123.45", "123"},
+		{"%.3g", "1234.5", "1.23e+03"},
+		{"%G", "1.5E-7", "1.5E-07"},
+		{"%g", "0E+3", "0"},
+		{"%g", "0E+10", "0"},
+		{"%g", "0E-10", "0"},
+		{"%#.3g", "0E+3", "0.00"},
+
+		{"%+.1f", "1.25", "+1.2"},
+		{"% .1f", "1.25", " 1.2"},
+		{"%+.1f", "-1.25", "-1.2"},
+		{"%8.2f", "-1.5", "   -1.50"},
+		{"%-8.2f|", "1.5", "1.50    |"},
+		{"%08.2f", "-1.5", "-0001.50"},
+		{"%+08.2f", "1.5", "+0001.50"},
+		{"%-08.2f|", "1.5", "1.50    |"},
+
+		{"%f", "Infinity", "Infinity"},
+		{"%+e", "Infinity", "+Infinity"},
+		{"%g", "-Infinity", "-Infinity"},
+		{"%010f", "-Infinity", " -Infinity"},
+		{"%.2f", "NaN", "NaN"},
+
+		{"%z", "1.5", "%!z(fixedpoint.X64=1.5)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format+"/"+tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.expected, fmt.Sprintf(tt.format, MustParse64(tt.value)))
+		})
+	}
+}
+
+func TestFormatMatchesFloat(t *testing.T) {
+	values := []string{"0", "1", "-1", "0.5", "1.25", "-2.75", "1234.5", "100000", "1234567", "0.0001", "0.00001", "3.0625", "1E+20"}
+	formats := []string{"%.0f", "%.1f", "%.3f", "%8.2f", "%-9.1f|", "%+.2f", "%09.3f", "%.0e", "%.2e", "%.5E", "%.1g", "%.3g", "%.10g", "%g", "%12.4g"}
+
+	for _, s := range values {
+		f, err := strconv.ParseFloat(s, 64)
+		assert.NoError(t, err)
+
+		for _, format := range formats {
+			assert.Equal(t, fmt.Sprintf(format, f), fmt.Sprintf(format, MustParse64(s)), "%s %s", format, s)
+			assert.Equal(t, fmt.Sprintf(format, f), fmt.Sprintf(format, MustParse32(s)), "%s %s", format, s)
+		}
+	}
+}
+
+func TestFormatRounding(t *testing.T) {
+	saved := DefaultContext64
+	defer func() { DefaultContext64 = saved }()
+
+	ctx, err := NewContext64(PrecisionMaximum64, RoundTowardZero, BasicTraps, DefaultLocale)
+	assert.NoError(t, err)
+	DefaultContext64 = ctx
+
+	assert.Equal(t, "1.99", fmt.Sprintf("%.2f", MustParse64("1.999")))
+	assert.Equal(t, "-1.99", fmt.Sprintf("%.2f", MustParse64("-1.999")))
+}
+
+func TestFormatBits(t *testing.T) {
+	x := MustParse64("1.5")
+	assert.Equal(t, fmt.Sprintf("%#064b", x.Bits()), fmt.Sprintf("%#064b", x))
+	assert.Equal(t, fmt.Sprintf("%#016X", x.Bits()), fmt.Sprintf("%#016X", x))
+	assert.Equal(t, fmt.Sprintf("%020d", x.Bits()), fmt.Sprintf("%020d", x))
+	assert.Equal(t, "31a000000000000f", fmt.Sprintf("%x", x))
+
+	y := MustParse32("-123.45")
+	assert.Equal(t, "b1803039", fmt.Sprintf("%x", y))
+	assert.Equal(t, fmt.Sprintf("%#032b", y.Bits()), fmt.Sprintf("%#032b", y))
+	assert.Equal(t, `fixedpoint.MustParse32("-123.45")`, fmt.Sprintf("%#v", y))
+	assert.Equal(t, "-123.450", fmt.Sprintf("%.3f", y))
+}
+
+func TestFormatSpelling(t *testing.T) {
+	for _, x := range []X64{NaN64(), MustParse64("-NaN7"), MustParse64("sNaN"), Inf64(-1), MustParse64("1.20E+3")} {
+		s := fmt.Sprintf("%v", x)
+		assert.Equal(t, x.ToSciString(), s)
+		assert.Equal(t, x.String(), s)
+		assert.Equal(t, `fixedpoint.MustParse64("`+s+`")`, fmt.Sprintf("%#v", x))
+		assert.Equal(t, x.Bits(), MustParse64(s).Bits(), s)
+	}
+}
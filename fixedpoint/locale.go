@@ -0,0 +1,289 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Locale describes the decimal and grouping separators used in number text.
+type Locale struct {
+	decimals  string // The decimal separators.
+	thousands string // The thousands separators.
+	primary   uint8  // Digits in the group nearest the decimal point; 0 disables validation.
+	secondary uint8  // Digits in each further group; 0 repeats primary.
+}
+
+// DefaultLocale accepts "." as the decimal separator and "," or "_" to
+// group digits in threes.
+var DefaultLocale = Locale{
+	decimals:  ".",
+	thousands: ",_",
+	primary:   3,
+}
+
+// Common locales.
+var (
+	LocaleEnUS = Locale{decimals: ".", thousands: ",", primary: 3}
+	LocaleDeDE = Locale{decimals: ",", thousands: ".", primary: 3}
+	LocaleFrFR = Locale{decimals: ",", thousands: "\u00a0\u202f ", primary: 3}
+	LocaleEnIN = Locale{decimals: ".", thousands: ",", primary: 3, secondary: 2}
+	LocaleDeCH = Locale{decimals: ".", thousands: "'\u2019", primary: 3}
+)
+
+var locales = map[string]Locale{
+	"en-us": LocaleEnUS,
+	"de-de": LocaleDeDE,
+	"fr-fr": LocaleFrFR,
+	"en-in": LocaleEnIN,
+	"de-ch": LocaleDeCH,
+}
+
+// ErrInvalidLocale is returned when the separators or group sizes passed to
+// NewLocale cannot describe an unambiguous number format.
+var ErrInvalidLocale = fmt.Errorf("invalid locale")
+
+// NewLocale returns a Locale that accepts any rune of decimals as the decimal
+// separator and any rune of grouping as the grouping separator.
+//
+// groupSizes gives the number of digits in the group nearest the decimal
+// point, optionally followed by the size of every further group; for example
+// 3, 2 for Indian lakh grouping. It defaults to 3. A rune may not appear in
+// both decimals and grouping, and separators may not be digits, letters or
+// signs.
+func NewLocale(decimals, grouping string, groupSizes ...int) (Locale, error) {
+	if decimals == "" {
+		return Locale{}, fmt.Errorf("no decimal separator: %w", ErrInvalidLocale)
+	}
+
+	for _, r := range decimals + grouping {
+		if r == utf8.RuneError || r < utf8.RuneSelf && !isSeparatorByte(byte(r)) {
+			return Locale{}, fmt.Errorf("separator %q: %w", r, ErrInvalidLocale)
+		}
+	}
+
+	for _, r := range decimals {
+		if strings.ContainsRune(grouping, r) {
+			return Locale{}, fmt.Errorf("separator %q is both decimal and grouping: %w", r, ErrInvalidLocale)
+		}
+	}
+
+	if len(groupSizes) == 0 {
+		groupSizes = []int{3}
+	}
+	if len(groupSizes) > 2 {
+		return Locale{}, fmt.Errorf("%d group sizes: %w", len(groupSizes), ErrInvalidLocale)
+	}
+	for _, size := range groupSizes {
+		if size < 1 || size > 9 {
+			return Locale{}, fmt.Errorf("group size %d: %w", size, ErrInvalidLocale)
+		}
+	}
+
+	l := Locale{decimals: decimals, thousands: grouping, primary: uint8(groupSizes[0])}
+	if len(groupSizes) == 2 {
+		l.secondary = uint8(groupSizes[1])
+	}
+	return l, nil
+}
+
+// LookupLocale returns the predefined Locale for a language tag such as
+// "de-DE" or "en_IN". The match is case-insensitive.
+func LookupLocale(tag string) (Locale, bool) {
+	l, ok := locales[strings.ToLower(strings.ReplaceAll(tag, "_", "-"))]
+	return l, ok
+}
+
+// Decimals returns the accepted decimal separators.
+func (l Locale) Decimals() string {
+	return l.decimals
+}
+
+// Grouping returns the accepted grouping separators.
+func (l Locale) Grouping() string {
+	return l.thousands
+}
+
+// GroupSizes returns the number of digits in the group nearest the decimal
+// point and in each further group.
+func (l Locale) GroupSizes() (primary, secondary int) {
+	if l.secondary == 0 {
+		return int(l.primary), int(l.primary)
+	}
+	return int(l.primary), int(l.secondary)
+}
+
+// Locale retrieves the locale of the context.
+func (ctx *context) Locale() Locale {
+	if ctx == nil {
+		return Locale{}
+	}
+
+	return ctx.locale
+}
+
+// isSeparatorByte reports whether the ASCII byte c may be used as a separator.
+func isSeparatorByte(c byte) bool {
+	switch {
+	case '0' <= c && c <= '9', isLetter(c), c == '+', c == '-':
+		return false
+	default:
+		return true
+	}
+}
+
+// grouping validates the placement of grouping separators in the integer
+// part of a number as it is scanned.
+type grouping struct {
+	sep    rune // The separator in use, or 0 before the first one.
+	at     int  // Offset of the last separator.
+	count  int  // Number of separators seen.
+	digits int  // Digits since the last separator.
+}
+
+// separator records a grouping separator r at offset i and reports whether it
+// is legal there.
+func (g *grouping) separator(r rune, i int, l Locale) bool {
+	if l.primary == 0 {
+		return true
+	}
+
+	_, size := l.GroupSizes()
+	switch {
+	case g.sep != 0 && r != g.sep, g.digits == 0:
+		return false
+	case g.count == 0 && g.digits > size, g.count > 0 && g.digits != size:
+		return false
+	}
+
+	g.sep, g.at, g.count, g.digits = r, i, g.count+1, 0
+	return true
+}
+
+// end reports whether the integer part is legally grouped.
+func (g *grouping) end(l Locale) bool {
+	return l.primary == 0 || g.count == 0 || g.digits == int(l.primary)
+}
+
+// Digit systems for FormatOptions.Digits, identified by their zero digit.
+// Parsing digits other than DigitsLatin requires ParseUnicode.
+const (
+	DigitsLatin               rune = '0'
+	DigitsArabicIndic         rune = '\u0660'
+	DigitsExtendedArabicIndic rune = '\u06F0'
+	DigitsDevanagari          rune = '\u0966'
+	DigitsFullwidth           rune = '\uFF10'
+)
+
+// FormatOptions controls the output of Format. The zero value keeps every
+// digit of the value and groups the integer part.
+type FormatOptions struct {
+	MinFractionDigits int      // Pad the fraction with zeros to at least this many digits.
+	MaxFractionDigits int      // With RoundFraction, round to at most this many fraction digits.
+	RoundFraction     bool     // Round to MaxFractionDigits; otherwise every digit is kept.
+	NoGrouping        bool     // Omit grouping separators from the integer part.
+	Digits            rune     // The zero digit of the digit system; 0 or an unknown rune selects DigitsLatin.
+	Rounding          Rounding // The rounding mode used to drop fraction digits.
+}
+
+// DefaultFormatOptions keeps every digit of the value and groups the
+// integer part.
+var DefaultFormatOptions = FormatOptions{}
+
+// Format returns x in plain notation using the separators and group sizes of
+// l: the first rune of l.Decimals as the decimal separator and the first rune
+// of l.Grouping between groups. Infinities and NaNs use their ToSciString
+// form. The result parses back to the same value with a context that uses l,
+// and ParseUnicode if opts selects a non-Latin digit system; a positive
+// exponent comes back as trailing zeros.
+func Format[X X64 | X32](x X, l Locale, opts FormatOptions) string {
+	var buf [64]byte
+	return string(AppendLocale(buf[:0], x, l, opts))
+}
+
+// AppendLocale appends x formatted as by Format to dst and returns the
+// extended buffer.
+func AppendLocale[X X64 | X32](dst []byte, x X, l Locale, opts FormatOptions) []byte {
+	v, err := components(x, opts.Rounding)
+	if err != nil {
+		return appendError(dst, fmt.Sprintf("%T", x), err)
+	}
+
+	if v.k != kind_finite {
+		return appendToString(dst, v.k, v.sign, v.exp, v.coe, false)
+	}
+
+	coe, exp := v.coe, v.exp
+	if opts.RoundFraction {
+		coe, exp = v.round(coe, exp, -max(opts.MaxFractionDigits, opts.MinFractionDigits, 0))
+	}
+
+	zero := opts.Digits
+	if !isDigitZero(zero) {
+		zero = DigitsLatin
+	}
+
+	if v.sign == signc_negative {
+		dst = append(dst, '-')
+	}
+
+	// Integer part, grouped from the decimal point outward.
+	style := digitStyle{zero: zero, primary: int(l.primary), secondary: int(l.secondary)}
+	if !opts.NoGrouping {
+		style.sep = firstRune(l.thousands)
+	}
+	dst = appendInteger(dst, coe, exp, 1, style)
+
+	// Fraction part, padded to the minimum number of digits.
+	if exp >= 0 && opts.MinFractionDigits <= 0 {
+		return dst
+	}
+
+	decimal := firstRune(l.decimals)
+	if decimal == 0 {
+		decimal = '.'
+	}
+	dst = utf8.AppendRune(dst, decimal)
+	return appendFraction(dst, coe, exp, opts.MinFractionDigits, zero)
+}
+
+// components unpacks x for formatting with rounding mode r.
+func components[X X64 | X32](x X, r Rounding) (formatted, error) {
+	switch x := any(x).(type) {
+	case X64:
+		k, sign, exp, coe, err := x.unpack()
+		return formatted{k, sign, int(exp), coe, r}, err
+	case X32:
+		k, sign, exp, coe, err := x.unpack()
+		return formatted{k, sign, int(exp), uint64(coe), r}, err
+	}
+	panic("unreachable")
+}
+
+// isGroupBoundary reports whether a grouping separator belongs before the
+// last left digits of the integer part.
+func isGroupBoundary(left, primary, secondary int) bool {
+	return left == primary || left > primary && (left-primary)%secondary == 0
+}
+
+// isDigitZero reports whether r is the zero digit of a supported digit system.
+func isDigitZero(r rune) bool {
+	if r == DigitsLatin {
+		return true
+	}
+	for _, zero := range digitZeros {
+		if r == zero {
+			return true
+		}
+	}
+	return false
+}
+
+// firstRune returns the first rune of s, or 0 if s is empty.
+func firstRune(s string) rune {
+	if s == "" {
+		return 0
+	}
+	r, _ := utf8.DecodeRuneInString(s)
+	return r
+}
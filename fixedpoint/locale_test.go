@@ -0,0 +1,204 @@
+package fixedpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLocale(t *testing.T) {
+	tests := []struct {
+		name      string
+		decimals  string
+		grouping  string
+		sizes     []int
+		primary   int
+		secondary int
+		expectErr bool
+	}{
+		{"Default", ".", ",", nil, 3, 3, false},
+		{"Lakh", ".", ",", []int{3, 2}, 3, 2, false},
+		{"NoGrouping", ",", "", nil, 3, 3, false},
+		{"NoDecimal", "", ",", nil, 0, 0, true},
+		{"Ambiguous", ".,", ",", nil, 0, 0, true},
+		{"DigitSeparator", ".", "0", nil, 0, 0, true},
+		{"LetterSeparator", "e", ",", nil, 0, 0, true},
+		{"SignSeparator", ".", "-", nil, 0, 0, true},
+		{"ZeroGroup", ".", ",", []int{0}, 0, 0, true},
+		{"TooManySizes", ".", ",", []int{3, 2, 1}, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLocale(tt.decimals, tt.grouping, tt.sizes...)
+			if tt.expectErr {
+				assert.ErrorIs(t, err, ErrInvalidLocale)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.decimals, l.Decimals())
+			assert.Equal(t, tt.grouping, l.Grouping())
+			primary, secondary := l.GroupSizes()
+			assert.Equal(t, tt.primary, primary)
+			assert.Equal(t, tt.secondary, secondary)
+		})
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	l, ok := LookupLocale("de-DE")
+	assert.True(t, ok)
+	assert.Equal(t, LocaleDeDE, l)
+
+	l, ok = LookupLocale("en_in")
+	assert.True(t, ok)
+	assert.Equal(t, LocaleEnIN, l)
+
+	_, ok = LookupLocale("xx-XX")
+	assert.False(t, ok)
+}
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		locale   Locale
+		input    string
+		expected string
+		offset   int
+		err      error
+	}{
+		{LocaleEnUS, "1,234,567.89", "X64{+, 123456789, -2}", 0, nil},
+		{LocaleEnUS, "1234567.89", "X64{+, 123456789, -2}", 0, nil},
+		{LocaleEnUS, "12,34,567.89", "", 5, ErrMisplacedSeparator},
+		{LocaleEnUS, "1234,567", "", 4, ErrMisplacedSeparator},
+		{LocaleEnUS, "1,23", "", 1, ErrMisplacedSeparator},
+		{LocaleEnUS, "1,,234", "", 2, ErrMisplacedSeparator},
+		{LocaleEnUS, ",123", "", 0, ErrMisplacedSeparator},
+		{LocaleEnUS, "1,234,", "", 5, ErrMisplacedSeparator},
+		{LocaleEnUS, "1.234,5", "", 5, ErrMisplacedSeparator},
+		{LocaleDeDE, "1.234.567,89", "X64{+, 123456789, -2}", 0, nil},
+		{LocaleDeDE, "1,5", "X64{+, 15, -1}", 0, nil},
+		{LocaleDeDE, "1.5", "", 1, ErrMisplacedSeparator},
+		{LocaleDeDE, "1,2,3", "", 3, ErrMultipleDecimalPoints},
+		{LocaleFrFR, "1\u00a0234\u00a0567,89", "X64{+, 123456789, -2}", 0, nil},
+		{LocaleFrFR, "-1\u202f234,5\u00a0", "X64{-, 12345, -1}", 0, nil},
+		{LocaleFrFR, "1 234 567", "X64{+, 1234567, 0}", 0, nil},
+		{LocaleFrFR, "1\u00a0234 567", "", 6, ErrMisplacedSeparator},
+		{LocaleEnIN, "12,34,567.89", "X64{+, 123456789, -2}", 0, nil},
+		{LocaleEnIN, "1,00,00,000", "X64{+, 10000000, 0}", 0, nil},
+		{LocaleEnIN, "99,999", "X64{+, 99999, 0}", 0, nil},
+		{LocaleEnIN, "1,234,567", "", 5, ErrMisplacedSeparator},
+		{LocaleEnIN, "123,456", "", 3, ErrMisplacedSeparator},
+		{LocaleDeCH, "1'234'567.50", "X64{+, 123456750, -2}", 0, nil},
+		{LocaleDeCH, "1\u2019234.5", "X64{+, 12345, -1}", 0, nil},
+		{LocaleDeCH, "1'234\u2019567", "", 5, ErrMisplacedSeparator},
+		{DefaultLocale, "1_000_000", "X64{+, 1000000, 0}", 0, nil},
+		{DefaultLocale, "1,000e3", "X64{+, 1000, 3}", 0, nil},
+		{DefaultLocale, "10,00e3", "", 2, ErrMisplacedSeparator},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ctx, err := NewContext64(PrecisionMaximum64, BasicRounding, BasicTraps, tt.locale)
+			require.NoError(t, err)
+			assert.Equal(t, tt.locale, ctx.Locale())
+
+			x, err := ctx.ParseChecked(tt.input)
+			if tt.err == nil {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, x.Debug())
+				return
+			}
+
+			var perr *ParseError
+			require.ErrorAs(t, err, &perr)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.offset, perr.Offset)
+		})
+	}
+}
+
+func TestFormatLocale(t *testing.T) {
+	fixed := func(minDigits, maxDigits int) FormatOptions {
+		return FormatOptions{MinFractionDigits: minDigits, MaxFractionDigits: maxDigits, RoundFraction: true}
+	}
+
+	tests := []struct {
+		name     string
+		value    string
+		locale   Locale
+		opts     FormatOptions
+		expected string
+	}{
+		{"Default", "1234567.891", DefaultLocale, DefaultFormatOptions, "1,234,567.891"},
+		{"Small", "123", DefaultLocale, DefaultFormatOptions, "123"},
+		{"Fraction", "0.05", DefaultLocale, DefaultFormatOptions, "0.05"},
+		{"Cohort", "1.50", DefaultLocale, DefaultFormatOptions, "1.50"},
+		{"PositiveExponent", "1.2E+7", DefaultLocale, DefaultFormatOptions, "12,000,000"},
+		{"Negative", "-1234.5", LocaleEnUS, DefaultFormatOptions, "-1,234.5"},
+		{"German", "1234567.891", LocaleDeDE, DefaultFormatOptions, "1.234.567,891"},
+		{"French", "-9876543.21", LocaleFrFR, DefaultFormatOptions, "-9 876 543,21"},
+		{"Swiss", "1234.5", LocaleDeCH, DefaultFormatOptions, "1'234.5"},
+		{"Indian", "123456789.5", LocaleEnIN, DefaultFormatOptions, "12,34,56,789.5"},
+		{"IndianSmall", "12345", LocaleEnIN, DefaultFormatOptions, "12,345"},
+		{"NoGrouping", "1234567", LocaleDeDE, FormatOptions{NoGrouping: true}, "1234567"},
+		{"MinFraction", "1234", LocaleDeDE, FormatOptions{MinFractionDigits: 2}, "1.234,00"},
+		{"MaxFraction", "1234.5678", LocaleDeDE, fixed(0, 2), "1.234,57"},
+		{"MaxFractionTie", "0.125", DefaultLocale, fixed(0, 2), "0.12"},
+		{"MaxFractionCarry", "999.996", DefaultLocale, fixed(2, 2), "1,000.00"},
+		{"MaxZero", "2.5", DefaultLocale, fixed(0, 0), "2"},
+		{"MinAboveMax", "1.23456", DefaultLocale, fixed(3, 1), "1.235"},
+		{"RoundingMode", "1.239", DefaultLocale, FormatOptions{MaxFractionDigits: 2, RoundFraction: true, Rounding: RoundTowardZero}, "1.23"},
+		{"Devanagari", "1234.5", LocaleEnIN, FormatOptions{Digits: DigitsDevanagari}, "१,२३४.५"},
+		{"ArabicIndic", "-10", DefaultLocale, FormatOptions{Digits: DigitsArabicIndic}, "-١٠"},
+		{"UnknownDigits", "10", DefaultLocale, FormatOptions{Digits: 'x'}, "10"},
+		{"Infinity", "-Infinity", LocaleDeDE, DefaultFormatOptions, "-Infinity"},
+		{"NaN", "NaN", LocaleDeDE, fixed(2, 2), "NaN"},
+		{"ZeroPositiveExponent", "0E+3", DefaultLocale, DefaultFormatOptions, "0"},
+		{"ZeroPositiveExponentFraction", "-0E+3", LocaleDeDE, fixed(2, 2), "-0,00"},
+		{"ZeroNegativeExponent", "0E-2", LocaleDeDE, DefaultFormatOptions, "0,00"},
+		{"ZeroValue", "1234.5678", DefaultLocale, FormatOptions{}, "1,234.5678"},
+		{"MaxWithoutRound", "1234.5678", DefaultLocale, FormatOptions{MaxFractionDigits: 1}, "1,234.5678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := MustParse64(tt.value)
+			assert.Equal(t, tt.expected, Format(x, tt.locale, tt.opts))
+			assert.Equal(t, "x="+tt.expected, string(AppendLocale([]byte("x="), x, tt.locale, tt.opts)))
+		})
+	}
+}
+
+func TestFormatLocaleX32(t *testing.T) {
+	assert.Equal(t, "-12.345,67", Format(MustParse32("-12345.67"), LocaleDeDE, DefaultFormatOptions))
+	assert.Equal(t, "1,00,000.0", Format(MustParse32("1E+5"), LocaleEnIN, FormatOptions{MinFractionDigits: 1, MaxFractionDigits: 1, RoundFraction: true}))
+}
+
+func TestFormatLocaleRoundTrip(t *testing.T) {
+	values := []string{"0", "-0.00", "1", "12.5", "-1234.5", "1234567.891", "123456789012.3456", "1E+12", "0.000001", "9999999999999999", "-Infinity", "NaN"}
+	locales := []Locale{DefaultLocale, LocaleEnUS, LocaleDeDE, LocaleFrFR, LocaleEnIN, LocaleDeCH}
+	digits := []rune{0, DigitsArabicIndic, DigitsExtendedArabicIndic, DigitsDevanagari, DigitsFullwidth}
+
+	for _, l := range locales {
+		ctx, err := NewContext64(PrecisionMaximum64, BasicRounding, BasicTraps, l)
+		require.NoError(t, err)
+		ctx.SetParseOptions(ParseUnicode)
+
+		for _, d := range digits {
+			for _, s := range values {
+				x := MustParse64(s)
+				text := Format(x, l, FormatOptions{Digits: d})
+
+				ctx.ClearSignals()
+				got := ctx.Parse(text)
+				assert.Zero(t, ctx.Signal()&SignalConversionSyntax, "Parse(%q)", text)
+				assert.Equal(t, text, Format(got, l, FormatOptions{Digits: d}), "Parse(%q)", text)
+				if _, _, exp, _ := x.Components(); exp <= 0 {
+					assert.Equal(t, x.ToSciString(), got.ToSciString(), "Parse(%q)", text)
+				}
+			}
+		}
+	}
+}
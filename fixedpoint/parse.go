@@ -0,0 +1,565 @@
+package fixedpoint
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseOptions selects optional parsing behavior for a context.
+type ParseOptions uint8
+
+const (
+	// ParseStrict accepts only the plain number syntax: leading, trailing
+	// and embedded whitespace and grouping separators are rejected rather
+	// than skipped.
+	ParseStrict ParseOptions = 1 << iota
+
+	// ParseUnicode accepts common non-ASCII number text: the U+2212 minus
+	// sign, full-width, Arabic-Indic and Devanagari digits, no-break and
+	// thin spaces as grouping separators, and negatives written in
+	// accounting-style parentheses or with a trailing minus sign.
+	ParseUnicode
+)
+
+// SetParseOptions sets the options used when parsing with the context.
+func (ctx *context) SetParseOptions(opts ParseOptions) {
+	if ctx != nil {
+		ctx.options = opts
+	}
+}
+
+// ParseOptions retrieves the current parse options of the context.
+func (ctx *context) ParseOptions() ParseOptions {
+	if ctx == nil {
+		return ParseOptions(0)
+	}
+
+	return ctx.options
+}
+
+// ParseChecked is like Parse, but reports invalid input as a *ParseError
+// carrying the input, the byte offset of the problem and the reason.
+// If the value is too large for the format, the error wraps ErrOutOfRange and
+// the rounded result (infinity or the largest finite value) is returned.
+func (ctx *Context64) ParseChecked(s string) (X64, error) {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	x, off, err := ctx.build(scanNumber(s, ctx.locale, ctx.options))
+	if err != nil {
+		return x, &ParseError{Input: s, Offset: off, Err: err}
+	}
+
+	return x, nil
+}
+
+// ParseStrict is like ParseChecked, but always applies ParseStrict.
+func (ctx *Context64) ParseStrict(s string) (X64, error) {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	x, off, err := ctx.build(scanNumber(s, ctx.locale, ctx.options|ParseStrict))
+	if err != nil {
+		return x, &ParseError{Input: s, Offset: off, Err: err}
+	}
+
+	return x, nil
+}
+
+// ParseChecked is like Parse, but reports invalid input as a *ParseError.
+// See Context64.ParseChecked for details.
+func (ctx *Context32) ParseChecked(s string) (X32, error) {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	x, off, err := ctx.build(scanNumber(s, ctx.locale, ctx.options))
+	if err != nil {
+		return x, &ParseError{Input: s, Offset: off, Err: err}
+	}
+
+	return x, nil
+}
+
+// ParseStrict is like ParseChecked, but always applies ParseStrict.
+func (ctx *Context32) ParseStrict(s string) (X32, error) {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	x, off, err := ctx.build(scanNumber(s, ctx.locale, ctx.options|ParseStrict))
+	if err != nil {
+		return x, &ParseError{Input: s, Offset: off, Err: err}
+	}
+
+	return x, nil
+}
+
+// build converts the result of scanNumber to a value, returning the offset
+// and reason of any failure. Invalid input yields a signaling NaN and
+// SignalConversionSyntax.
+func (ctx *Context64) build(d decimal, off int, err error) (X64, int, error) {
+	if err != nil {
+		ctx.signals |= SignalConversionSyntax
+		return newSpecial64(signc_positive, kind_signaling), off, err
+	}
+
+	if d.kind != kind_finite {
+		if d.coe > uint64(maxPayload64) {
+			ctx.signals |= SignalConversionSyntax
+			return newSpecial64(signc_positive, kind_signaling), d.expOffset, ErrOutOfRange
+		}
+
+		var x X64
+		if err := x.pack(d.kind, d.sign, 0, uint64(d.coe)); err != nil {
+			panic(err)
+		}
+		return x, 0, nil
+	}
+
+	saved := ctx.signals
+	ctx.signals = SignalClear
+	x := ctx.finish(d.sign, d.coe, d.exp, d.sticky)
+	raised := ctx.signals
+	ctx.signals |= saved
+
+	if raised&SignalOverflow != 0 {
+		return x, d.expOffset, ErrOutOfRange
+	}
+	return x, 0, nil
+}
+
+// build converts the result of scanNumber to a value.
+// See Context64.build for details.
+func (ctx *Context32) build(d decimal, off int, err error) (X32, int, error) {
+	if err != nil {
+		ctx.signals |= SignalConversionSyntax
+		return newSpecial32(signc_positive, kind_signaling), off, err
+	}
+
+	if d.kind != kind_finite {
+		if d.coe > uint64(maxPayload32) {
+			ctx.signals |= SignalConversionSyntax
+			return newSpecial32(signc_positive, kind_signaling), d.expOffset, ErrOutOfRange
+		}
+
+		var x X32
+		if err := x.pack(d.kind, d.sign, 0, uint32(d.coe)); err != nil {
+			panic(err)
+		}
+		return x, 0, nil
+	}
+
+	saved := ctx.signals
+	ctx.signals = SignalClear
+	x := ctx.finish(d.sign, d.coe, d.exp, d.sticky)
+	raised := ctx.signals
+	ctx.signals |= saved
+
+	if raised&SignalOverflow != 0 {
+		return x, d.expOffset, ErrOutOfRange
+	}
+	return x, 0, nil
+}
+
+// decimal holds the components of a scanned number before rounding.
+type decimal struct {
+	sign      signc
+	kind      kind
+	coe       uint64
+	exp       int
+	sticky    bool // Nonzero digits were dropped beyond the coefficient.
+	expOffset int  // Offset of the exponent, or of the digits if there is none.
+}
+
+// maxScanDigits is the number of significant digits kept while scanning.
+// Later digits only set the sticky flag, which is enough to round correctly
+// to any supported precision.
+const maxScanDigits = 19
+
+// maxExponentMagnitude bounds parsed exponents. Anything larger is far outside
+// every format's range, so saturating keeps the arithmetic from overflowing
+// without changing the result.
+const maxExponentMagnitude = 999_999_999
+
+// scanNumber reads a number or special value from s in a single pass.
+// On failure it returns the byte offset of the problem and its reason.
+func scanNumber[T text](s T, locale Locale, opts ParseOptions) (decimal, int, error) {
+	sc := scanner[T]{
+		s:       s,
+		locale:  locale,
+		strict:  opts&ParseStrict != 0,
+		unicode: opts&ParseUnicode != 0,
+	}
+	if !sc.strict {
+		sc.s = trimRightSpace(s)
+	}
+
+	d, err := sc.scan()
+	return d, sc.i, err
+}
+
+// scanner reads a number from its input in a single pass. On failure the
+// offset is left at the problem.
+type scanner[T text] struct {
+	s       T
+	i       int
+	locale  Locale
+	strict  bool
+	unicode bool
+}
+
+func (sc *scanner[T]) scan() (decimal, error) {
+	d := decimal{sign: signc_positive, kind: kind_finite}
+	if err := sc.skipSpace(); err != nil {
+		return d, err
+	}
+
+	r, n := sc.peek()
+	if r < 0 {
+		return d, ErrEmpty
+	}
+
+	// A sign, or under ParseUnicode an accounting-style opening parenthesis.
+	paren, signed := false, false
+	switch {
+	case r == '-':
+		d.sign, signed = signc_negative, true
+		sc.i += n
+	case r == '+':
+		signed = true
+		sc.i += n
+	case r == '(' && sc.unicode:
+		d.sign, paren = signc_negative, true
+		sc.i += n
+	}
+
+	if r, _ = sc.peek(); r >= 0 && r < utf8.RuneSelf && isLetter(byte(r)) && !paren {
+		return sc.scanSpecial(d)
+	}
+
+	// Coefficient digits with an optional decimal point.
+	d.expOffset = sc.i
+	var g grouping
+	digits, kept, frac, dropped, point := 0, 0, 0, 0, false
+loop:
+	for {
+		r, n := sc.peek()
+		switch {
+		case r < 0, r == 'e', r == 'E':
+			break loop
+		case '0' <= r && r <= '9':
+			digits++
+			if !point {
+				g.digits++
+			}
+			switch {
+			case kept < maxScanDigits:
+				if d.coe != 0 || r != '0' {
+					kept++
+				}
+				d.coe = d.coe*10 + uint64(r-'0')
+				if point {
+					frac++
+				}
+			case !point:
+				d.sticky = d.sticky || r != '0'
+				dropped++
+			default:
+				d.sticky = d.sticky || r != '0'
+			}
+		case strings.ContainsRune(sc.locale.decimals, r):
+			if point {
+				return d, ErrMultipleDecimalPoints
+			}
+			if !g.end(sc.locale) {
+				sc.i = g.at
+				return d, ErrMisplacedSeparator
+			}
+			point = true
+		case strings.ContainsRune(sc.locale.thousands, r), sc.unicode && isGroupSpace(r):
+			if sc.strict || point || !g.separator(r, sc.i, sc.locale) {
+				return d, ErrMisplacedSeparator
+			}
+		case unicode.IsSpace(r):
+			if sc.strict {
+				return d, ErrUnexpectedWhitespace
+			}
+		case sc.isSuffix(r, paren, signed):
+			break loop
+		default:
+			return d, ErrInvalidDigit
+		}
+		sc.i += n
+	}
+
+	if digits == 0 {
+		return d, ErrMissingDigits
+	}
+	if !point && !g.end(sc.locale) {
+		sc.i = g.at
+		return d, ErrMisplacedSeparator
+	}
+
+	exp := 0
+	if r, n := sc.peek(); r == 'e' || r == 'E' {
+		d.expOffset = sc.i
+		sc.i += n
+
+		var err error
+		if exp, err = sc.scanExponent(paren, signed); err != nil {
+			return d, err
+		}
+	}
+	d.exp = exp - frac + dropped
+
+	if err := sc.scanSuffix(&d, paren, signed); err != nil {
+		return d, err
+	}
+
+	return d, nil
+}
+
+// scanExponent reads the optionally signed exponent digits.
+func (sc *scanner[T]) scanExponent(paren, signed bool) (int, error) {
+	neg := false
+	if r, n := sc.peek(); r == '-' || r == '+' {
+		neg = r == '-'
+		sc.i += n
+	}
+
+	exp, digits := 0, 0
+loop:
+	for {
+		r, n := sc.peek()
+		switch {
+		case r < 0:
+			break loop
+		case '0' <= r && r <= '9':
+			exp = min(exp*10+int(r-'0'), maxExponentMagnitude)
+			digits++
+		case unicode.IsSpace(r):
+			if sc.strict {
+				return 0, ErrUnexpectedWhitespace
+			}
+		case sc.isSuffix(r, paren, signed):
+			break loop
+		default:
+			return 0, ErrInvalidDigit
+		}
+		sc.i += n
+	}
+
+	if digits == 0 {
+		return 0, ErrMissingDigits
+	}
+
+	if neg {
+		return -exp, nil
+	}
+	return exp, nil
+}
+
+// isSuffix reports whether r ends a number under ParseUnicode: the closing
+// parenthesis of an accounting-style negative, or a trailing minus sign when
+// there is no leading sign.
+func (sc *scanner[T]) isSuffix(r rune, paren, signed bool) bool {
+	if !sc.unicode {
+		return false
+	}
+
+	return paren && r == ')' || !paren && !signed && r == '-'
+}
+
+// scanSuffix reads the closing parenthesis or trailing minus sign, if any,
+// and checks that the input is exhausted.
+func (sc *scanner[T]) scanSuffix(d *decimal, paren, signed bool) error {
+	r, n := sc.peek()
+	switch {
+	case paren && r != ')':
+		return ErrUnbalancedParentheses
+	case sc.isSuffix(r, paren, signed):
+		d.sign = signc_negative
+		sc.i += n
+	}
+
+	if err := sc.skipSpace(); err != nil {
+		return err
+	}
+	if r, _ := sc.peek(); r >= 0 {
+		return ErrInvalidDigit
+	}
+
+	return nil
+}
+
+// maxScanPayload bounds scanned NaN payloads; it exceeds every format's
+// largest payload.
+const maxScanPayload = 1_000_000_000_000_000_000
+
+// scanSpecial reads a special value through the end of input: an infinity,
+// or a quiet or signaling NaN with an optional decimal payload in coe.
+func (sc *scanner[T]) scanSpecial(d decimal) (decimal, error) {
+	word := sc.s[sc.i:]
+	switch {
+	case hasPrefixFold(word, "infinity"):
+		d.kind = kind_infinity
+		sc.i += len("infinity")
+	case hasPrefixFold(word, "inf"):
+		d.kind = kind_infinity
+		sc.i += len("inf")
+	case hasPrefixFold(word, "snan"):
+		d.kind = kind_signaling
+		sc.i += len("snan")
+	case hasPrefixFold(word, "qnan"):
+		d.kind = kind_quiet
+		sc.i += len("qnan")
+	case hasPrefixFold(word, "nan"):
+		d.kind = kind_quiet
+		sc.i += len("nan")
+	default:
+		return d, ErrInvalidDigit
+	}
+
+	// Only NaNs are followed by a payload.
+	d.expOffset = sc.i
+	for ; sc.i < len(sc.s); sc.i++ {
+		c := sc.s[sc.i]
+		switch {
+		case '0' <= c && c <= '9' && d.kind != kind_infinity:
+			d.coe = min(d.coe*10+uint64(c-'0'), maxScanPayload)
+		default:
+			if r, _ := sc.peek(); unicode.IsSpace(r) {
+				return d, ErrUnexpectedWhitespace
+			}
+			return d, ErrInvalidDigit
+		}
+	}
+
+	return d, nil
+}
+
+// hasPrefixFold reports whether s begins with the ASCII prefix,
+// ignoring case.
+func hasPrefixFold[T text](s T, prefix string) bool {
+	return len(s) >= len(prefix) && equalFold(s[:len(prefix)], prefix)
+}
+
+// equalFold reports whether s equals the lower-case ASCII word,
+// ignoring case.
+func equalFold[T text](s T, word string) bool {
+	if len(s) != len(word) {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i]|0x20 != word[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// text is the input accepted by the scanner.
+type text interface {
+	string | []byte
+}
+
+// decodeRune decodes the first rune of s. Converting at most utf8.UTFMax
+// bytes to a string does not allocate.
+func decodeRune[T text](s T) (rune, int) {
+	return utf8.DecodeRuneInString(string(s[:min(len(s), utf8.UTFMax)]))
+}
+
+// trimRightSpace returns s without trailing whitespace.
+func trimRightSpace[T text](s T) T {
+	for len(s) > 0 {
+		r, n := rune(s[len(s)-1]), 1
+		if r >= utf8.RuneSelf {
+			r, n = utf8.DecodeLastRuneInString(string(s[max(0, len(s)-utf8.UTFMax):]))
+		}
+		if !unicode.IsSpace(r) {
+			break
+		}
+		s = s[:len(s)-n]
+	}
+	return s
+}
+
+// skipSpace advances past whitespace, which is an error in strict mode.
+func (sc *scanner[T]) skipSpace() error {
+	for {
+		r, n := sc.peek()
+		if r < 0 || !unicode.IsSpace(r) {
+			return nil
+		}
+		if sc.strict {
+			return ErrUnexpectedWhitespace
+		}
+		sc.i += n
+	}
+}
+
+// peek returns the rune at the current offset and its width, or -1 at the
+// end of input. Under ParseUnicode the rune is mapped to its ASCII equivalent.
+func (sc *scanner[T]) peek() (rune, int) {
+	if sc.i >= len(sc.s) {
+		return -1, 0
+	}
+
+	if c := sc.s[sc.i]; c < utf8.RuneSelf {
+		return rune(c), 1
+	}
+
+	r, n := decodeRune(sc.s[sc.i:])
+	if sc.unicode {
+		r = canonicalRune(r)
+	}
+	return r, n
+}
+
+// digitZeros holds the zero digit of each decimal digit block accepted under
+// ParseUnicode: Arabic-Indic, Extended Arabic-Indic, Devanagari and full-width.
+var digitZeros = [...]rune{DigitsArabicIndic, DigitsExtendedArabicIndic, DigitsDevanagari, DigitsFullwidth}
+
+// canonicalRune maps the Unicode signs, digits and punctuation accepted under
+// ParseUnicode to their ASCII equivalents.
+func canonicalRune(r rune) rune {
+	switch r {
+	case '\u2212', '\uFE63', '\uFF0D': // Minus sign, small and full-width hyphen-minus
+		return '-'
+	case '\uFE62', '\uFF0B': // Small and full-width plus sign
+		return '+'
+	case '\uFF0E':
+		return '.'
+	case '\uFF0C':
+		return ','
+	case '\uFF08':
+		return '('
+	case '\uFF09':
+		return ')'
+	case '\uFF25', '\uFF45':
+		return 'e'
+	}
+
+	for _, zero := range digitZeros {
+		if zero <= r && r <= zero+9 {
+			return '0' + r - zero
+		}
+	}
+
+	return r
+}
+
+// isGroupSpace reports whether r is a space used to group digits:
+// no-break space, narrow no-break space or thin space.
+func isGroupSpace(r rune) bool {
+	return r == '\u00A0' || r == '\u202F' || r == '\u2009'
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c|0x20 && c|0x20 <= 'z'
+}
@@ -0,0 +1,317 @@
+package fixedpoint
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChecked(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		offset   int
+		err      error
+	}{
+		{"123.45", "X64{+, 12345, -2}", 0, nil},
+		{" 1,234.5 ", "X64{+, 12345, -1}", 0, nil},
+		{"1 000", "X64{+, 1000, 0}", 0, nil},
+		{"-INF", "X64{Inf, -}", 0, nil},
+		{"nan ", "X64{qNaN, +}", 0, nil},
+		{"", "", 0, ErrEmpty},
+		{"   ", "", 0, ErrEmpty},
+		{"12a4", "", 2, ErrInvalidDigit},
+		{"-bogus", "", 1, ErrInvalidDigit},
+		{"1.2.3", "", 3, ErrMultipleDecimalPoints},
+		{"-.", "", 2, ErrMissingDigits},
+		{"1e+", "", 3, ErrMissingDigits},
+		{"1e5x", "", 3, ErrInvalidDigit},
+		{"123456789012345678901", "X64{+, 123456789, 12}", 0, nil},
+		{"1E400", "X64{Inf, +}", 1, ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ctx := BasicContext64()
+			x, err := ctx.ParseChecked(tt.input)
+			if tt.err == nil {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, x.Debug())
+				return
+			}
+
+			var perr *ParseError
+			require.True(t, errors.As(err, &perr), "expected *ParseError, got %v", err)
+			assert.Equal(t, tt.input, perr.Input)
+			assert.Equal(t, tt.offset, perr.Offset)
+			assert.ErrorIs(t, err, tt.err)
+			if tt.expected != "" {
+				assert.Equal(t, tt.expected, x.Debug())
+			} else {
+				assert.NotZero(t, ctx.signals&SignalConversionSyntax)
+			}
+		})
+	}
+}
+
+func TestParseStrict(t *testing.T) {
+	tests := []struct {
+		input  string
+		offset int
+		err    error
+	}{
+		{"-123.45e-2", 0, nil},
+		{"Infinity", 0, nil},
+		{" 1", 0, ErrUnexpectedWhitespace},
+		{"1 ", 1, ErrUnexpectedWhitespace},
+		{"1 000", 1, ErrUnexpectedWhitespace},
+		{"1,000", 1, ErrMisplacedSeparator},
+		{"1_000", 1, ErrMisplacedSeparator},
+		{"1e 5", 2, ErrUnexpectedWhitespace},
+		{"nan ", 3, ErrUnexpectedWhitespace},
+		{"sNaN12\t", 6, ErrUnexpectedWhitespace},
+		{"Infinity ", 8, ErrUnexpectedWhitespace},
+		{"inf5", 3, ErrInvalidDigit},
+		{"nan1x", 4, ErrInvalidDigit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := BasicContext32().ParseStrict(tt.input)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var perr *ParseError
+			require.True(t, errors.As(err, &perr), "expected *ParseError, got %v", err)
+			assert.Equal(t, tt.offset, perr.Offset)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseOptions(t *testing.T) {
+	ctx := BasicContext64()
+	assert.Equal(t, ParseOptions(0), ctx.ParseOptions())
+
+	ctx.SetParseOptions(ParseStrict)
+	assert.Equal(t, ParseStrict, ctx.ParseOptions())
+	assert.Equal(t, ParseStrict, ctx.Clone(true).ParseOptions())
+
+	ctx.Parse("1,000")
+	assert.NotZero(t, ctx.signals&SignalConversionSyntax)
+
+	_, err := ctx.ParseChecked(" 1")
+	assert.ErrorIs(t, err, ErrUnexpectedWhitespace)
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	_, err := BasicContext64().ParseChecked("1.2.3")
+	assert.EqualError(t, err, `fixedpoint: parsing "1.2.3": multiple decimal points at offset 3`)
+}
+
+func TestParseLongInput(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		signals  Signal
+	}{
+		{"0.12345678901234567890", "X64{+, 1234567890123457, -16}", SignalInexact | SignalRounding},
+		{"1234567890123456789012345", "X64{+, 1234567890123457, 9}", SignalInexact | SignalRounding},
+		{"1.0000000000000005000000000", "X64{+, 1000000000000000, -15}", SignalInexact | SignalRounding},
+		{"1.0000000000000005000000000001", "X64{+, 1000000000000001, -15}", SignalInexact | SignalRounding},
+		{"99999999999999999999999999", "X64{+, 1000000000000000, 11}", SignalInexact | SignalRounding},
+		{"1000000000000000000000000", "X64{+, 1000000000000000, 9}", SignalRounding},
+		{"0000000000000000000000001.5", "X64{+, 15, -1}", SignalClear},
+		{"0.000000000000000000000000000000", "X64{+, 0, -30}", SignalClear},
+		{"-123456789012345678901234567890E-10", "X64{-, 1234567890123457, 4}", SignalInexact | SignalRounding},
+		{"9999999999999999999E366", "X64{Inf, +}", SignalOverflow | SignalInexact | SignalRounding},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ctx, err := NewContext64(PrecisionMaximum64, BasicRounding, SignalClear, DefaultLocale)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, ctx.Parse(tt.input).Debug())
+			assert.Equal(t, tt.signals, ctx.signals)
+		})
+	}
+
+	ctx := BasicContext32()
+	assert.Equal(t, "X32{+, 12346, 3}", ctx.Parse("12345678.9012345678901234").Debug())
+	assert.Equal(t, SignalInexact|SignalRounding, ctx.signals)
+}
+
+func TestParseUnicode(t *testing.T) {
+	tests := []struct {
+		locale   Locale
+		input    string
+		expected string
+		offset   int
+		err      error
+	}{
+		{DefaultLocale, "−1,234.5", "X64{-, 12345, -1}", 0, nil},
+		{DefaultLocale, "1.5e−3", "X64{+, 15, -4}", 0, nil},
+		{DefaultLocale, "1\u00a0234\u00a0567.5", "X64{+, 12345675, -1}", 0, nil},
+		{DefaultLocale, "1\u202f234.5", "X64{+, 12345, -1}", 0, nil},
+		{DefaultLocale, "1\u00a02345", "", 1, ErrMisplacedSeparator},
+		{DefaultLocale, "１２３．４５", "X64{+, 12345, -2}", 0, nil},
+		{DefaultLocale, "－１，２３４", "X64{-, 1234, 0}", 0, nil},
+		{DefaultLocale, "١٢٣.٤٥", "X64{+, 12345, -2}", 0, nil},
+		{DefaultLocale, "۱۲۳", "X64{+, 123, 0}", 0, nil},
+		{DefaultLocale, "१,२३४.५", "X64{+, 12345, -1}", 0, nil},
+		{DefaultLocale, "(1,234.56)", "X64{-, 123456, -2}", 0, nil},
+		{DefaultLocale, " ( 42 ) ", "X64{-, 42, 0}", 0, nil},
+		{DefaultLocale, "（7）", "X64{-, 7, 0}", 0, nil},
+		{DefaultLocale, "1,234.56-", "X64{-, 123456, -2}", 0, nil},
+		{DefaultLocale, "5E2−", "X64{-, 5, 2}", 0, nil},
+		{DefaultLocale, "(1.5", "", 4, ErrUnbalancedParentheses},
+		{DefaultLocale, "1.5)", "", 3, ErrInvalidDigit},
+		{DefaultLocale, "(-1.5)", "", 1, ErrInvalidDigit},
+		{DefaultLocale, "-1.5-", "", 4, ErrInvalidDigit},
+		{DefaultLocale, "(1.5)-", "", 5, ErrInvalidDigit},
+		{DefaultLocale, "1.5--", "", 4, ErrInvalidDigit},
+		{LocaleDeDE, "−1.234,5", "X64{-, 12345, -1}", 0, nil},
+		{LocaleFrFR, "1\u202f234,5", "X64{+, 12345, -1}", 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ctx, err := NewContext64(PrecisionMaximum64, BasicRounding, BasicTraps, tt.locale)
+			require.NoError(t, err)
+			ctx.SetParseOptions(ParseUnicode)
+
+			x, err := ctx.ParseChecked(tt.input)
+			if tt.err == nil {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, x.Debug())
+				return
+			}
+
+			var perr *ParseError
+			require.ErrorAs(t, err, &perr)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.offset, perr.Offset)
+		})
+	}
+
+	ctx := BasicContext64()
+	for _, s := range []string{"−1", "１", "(1)", "1-"} {
+		ctx.ClearSignals()
+		ctx.Parse(s)
+		assert.NotZero(t, ctx.signals&SignalConversionSyntax, "expected %q to need ParseUnicode", s)
+	}
+}
+
+func TestParseSpecials(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"sNaN", "X64{sNaN, +}", nil},
+		{"-sNaN", "X64{sNaN, -}", nil},
+		{"qNaN", "X64{qNaN, +}", nil},
+		{"-QNAN", "X64{qNaN, -}", nil},
+		{"NaN123", "X64{qNaN, +, 123}", nil},
+		{"-sNaN0042", "X64{sNaN, -, 42}", nil},
+		{"qNaN0", "X64{qNaN, +}", nil},
+		{"NaN999999999999999", "X64{qNaN, +, 999999999999999}", nil},
+		{"NaN1000000000000000", "", ErrOutOfRange},
+		{"NaN99999999999999999999999", "", ErrOutOfRange},
+		{"NaN1.5", "", ErrInvalidDigit},
+		{"sNaNx", "", ErrInvalidDigit},
+		{"Inf1", "", ErrInvalidDigit},
+		{"-0", "X64{-, 0, 0}", nil},
+		{"-0.00", "X64{-, 0, -2}", nil},
+		{"+0E+5", "X64{+, 0, 5}", nil},
+		{"-0E-500", "X64{-, 0, -398}", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			x, err := BasicContext64().ParseChecked(tt.input)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, x.Debug())
+		})
+	}
+
+	x := BasicContext32().Parse("-sNaN999999")
+	assert.Equal(t, "X32{sNaN, -, 999999}", x.Debug())
+	assert.Equal(t, "-sNaN999999", x.String())
+
+	ctx := BasicContext32()
+	ctx.Parse("NaN1000000")
+	assert.NotZero(t, ctx.signals&SignalConversionSyntax)
+}
+
+func TestParseStringIdentity(t *testing.T) {
+	values := []X64{
+		NaN64(),
+		newSpecial64(signc_negative, kind_signaling),
+		MustParse64("NaN123"),
+		MustParse64("-sNaN45"),
+		Inf64(1),
+		Inf64(-1),
+		MustParse64("-0"),
+		MustParse64("0"),
+		MustParse64("-123.45"),
+		MustParse64("1.234e+13"),
+		MustParse64("9.999999999999999E-383"),
+		MustParse64("1.20E+3"),
+		MustParse64("1200"),
+		MustParse64("0E-2"),
+		MustParse64("-0.00"),
+		MustParse64("0E+3"),
+		MustParse64("5E-7"),
+		MustParse64("1E+20"),
+		MustParse64("1.000000E-7"),
+		MustParse64("1E-398"),
+		MustParse64("9999999999999999E+369"),
+	}
+
+	ctx64, err := NewContext64(PrecisionMaximum64, BasicRounding, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	for _, x := range values {
+		t.Run(x.Debug(), func(t *testing.T) {
+			y, err := ctx64.ParseChecked(x.String())
+			require.NoError(t, err)
+			assert.Equal(t, x.Bits(), y.Bits(), "String() = %q", x.String())
+		})
+	}
+
+	values32 := []X32{
+		NaN32(),
+		MustParse32("-sNaN45"),
+		Inf32(-1),
+		MustParse32("-0"),
+		MustParse32("1.20E+3"),
+		MustParse32("0E-2"),
+		MustParse32("0E+3"),
+		MustParse32("5E-7"),
+		MustParse32("1E+20"),
+		MustParse32("1E-101"),
+		MustParse32("9999999E+90"),
+	}
+
+	ctx32, err := NewContext32(PrecisionMaximum32, BasicRounding, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	for _, x := range values32 {
+		t.Run(x.Debug(), func(t *testing.T) {
+			y, err := ctx32.ParseChecked(x.String())
+			require.NoError(t, err)
+			assert.Equal(t, x.Bits(), y.Bits(), "String() = %q", x.String())
+		})
+	}
+}
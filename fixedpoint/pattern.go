@@ -0,0 +1,361 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidPattern is returned when ParsePattern cannot interpret a
+// decimal format pattern.
+var ErrInvalidPattern = fmt.Errorf("invalid pattern")
+
+// Pattern is a compiled decimal format pattern in the style of ICU
+// DecimalFormat, such as "#,##0.00;(#,##0.00)" or "0.###E0".
+//
+// A pattern is a positive subpattern, optionally followed by ';' and a
+// negative subpattern whose affixes replace those of the positive one for
+// negative values. Each subpattern is a prefix, a number part and a suffix.
+// In the number part:
+//
+//	0  a digit that is always shown
+//	#  a digit that is shown unless it is a leading or trailing zero
+//	,  a grouping separator; the group sizes come from its positions
+//	.  the decimal separator
+//	E  starts the exponent, written as E0, E00 or E+0 to always show its sign
+//
+// In the affixes, '%' multiplies the value by 100 and '‰' by 1000, and text
+// between single quotes is literal, with a doubled quote standing for
+// itself. Rounding increments, significant digit patterns ('@') and padding
+// ('*') are not supported.
+type Pattern struct {
+	Locale   Locale   // Supplies the decimal and grouping separators that replace '.' and ','.
+	Rounding Rounding // The rounding mode used to drop digits the pattern does not show.
+
+	source             string
+	prefix, suffix     string // Affixes of non-negative values.
+	negPrefix          string // Affixes of negative values.
+	negSuffix          string
+	minInt, maxInt     int  // Digits before the decimal point.
+	minFrac, maxFrac   int  // Digits after the decimal point.
+	primary, secondary int  // Group sizes, 0 when not grouped.
+	decimalShown       bool // Always show the decimal separator.
+	minExp             int  // Minimum exponent digits, 0 for plain notation.
+	expSign            bool // Show the sign of non-negative exponents.
+	scale              int  // Power of ten applied by '%' or '‰'.
+}
+
+// ParsePattern compiles a decimal format pattern. The returned Pattern uses
+// DefaultLocale and BasicRounding; change its fields to select others.
+func ParsePattern(s string) (Pattern, error) {
+	p := Pattern{Locale: DefaultLocale, Rounding: BasicRounding, source: s}
+
+	rest, err := p.subpattern(s, true)
+	if err != nil {
+		return Pattern{}, err
+	}
+
+	p.negPrefix, p.negSuffix = "-"+p.prefix, p.suffix
+	if rest != "" {
+		if rest[0] != ';' {
+			return Pattern{}, fmt.Errorf("unexpected %q: %w", rest, ErrInvalidPattern)
+		}
+
+		neg := Pattern{}
+		if rest, err = neg.subpattern(rest[1:], false); err != nil {
+			return Pattern{}, err
+		}
+		if rest != "" {
+			return Pattern{}, fmt.Errorf("unexpected %q: %w", rest, ErrInvalidPattern)
+		}
+		p.negPrefix, p.negSuffix = neg.prefix, neg.suffix
+	}
+
+	return p, nil
+}
+
+// MustParsePattern is like ParsePattern but panics if s is invalid. It
+// simplifies the initialization of package-level patterns.
+func MustParsePattern(s string) Pattern {
+	p, err := ParsePattern(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns the source of the pattern.
+func (p Pattern) String() string {
+	return p.source
+}
+
+// FormatPattern returns x formatted by p. Infinities are written as
+// "Infinity" between the affixes and NaNs as "NaN".
+func FormatPattern[X X64 | X32](x X, p Pattern) string {
+	var buf [64]byte
+	return string(AppendPattern(buf[:0], x, p))
+}
+
+// AppendPattern appends x formatted by p to dst and returns the extended
+// buffer.
+func AppendPattern[X X64 | X32](dst []byte, x X, p Pattern) []byte {
+	v, err := components(x, p.Rounding)
+	if err != nil {
+		return appendError(dst, fmt.Sprintf("%T", x), err)
+	}
+
+	if v.k == kind_quiet || v.k == kind_signaling {
+		return append(dst, "NaN"...)
+	}
+
+	prefix, suffix := p.prefix, p.suffix
+	if v.sign == signc_negative {
+		prefix, suffix = p.negPrefix, p.negSuffix
+	}
+
+	dst = append(dst, prefix...)
+	switch {
+	case v.k == kind_infinity:
+		dst = append(dst, "Infinity"...)
+	case p.minExp > 0:
+		dst = p.appendScientific(dst, v)
+	default:
+		coe, exp := v.round(v.coe, v.exp+p.scale, -p.maxFrac)
+		coe, exp = p.trimFraction(coe, exp)
+		dst = p.appendDigits(dst, coe, exp, p.primary, p.secondary)
+	}
+	return append(dst, suffix...)
+}
+
+// appendScientific appends the mantissa and exponent of a finite value.
+func (p Pattern) appendScientific(dst []byte, v formatted) []byte {
+	coe, exp := v.coe, v.exp+p.scale
+
+	// With more maximum than minimum integer digits, the exponent is a
+	// multiple of the maximum, as in engineering notation, and the pattern
+	// limits the significant digits. Otherwise the exponent leaves minInt
+	// digits before the decimal point.
+	eng := p.maxInt > p.minInt && p.maxInt > 1
+	exponent := func(coe uint64, exp int) int {
+		adjusted := 0
+		if coe != 0 {
+			adjusted = exp + int(countDigits(coe)) - 1
+		}
+		if eng {
+			return adjusted - floorMod(adjusted, p.maxInt)
+		}
+		return adjusted - max(p.minInt, 1) + 1
+	}
+
+	if eng {
+		coe, exp = v.roundDigits(coe, exp, max(p.minInt+p.maxFrac, 1))
+	} else {
+		coe, exp = v.round(coe, exp, exponent(coe, exp)-p.maxFrac)
+	}
+	e := exponent(coe, exp)
+
+	coe, exp = p.trimFraction(coe, exp-e)
+	dst = p.appendDigits(dst, coe, exp, 0, 0)
+
+	dst = append(dst, 'E')
+	switch {
+	case e < 0:
+		dst = append(dst, '-')
+		e = -e
+	case p.expSign:
+		dst = append(dst, '+')
+	}
+	for n := len(strconv.Itoa(e)); n < p.minExp; n++ {
+		dst = append(dst, '0')
+	}
+	return strconv.AppendInt(dst, int64(e), 10)
+}
+
+// trimFraction drops trailing fraction zeros beyond the minimum number of
+// fraction digits.
+func (p Pattern) trimFraction(coe uint64, exp int) (uint64, int) {
+	for exp < -p.minFrac && coe%10 == 0 {
+		coe, exp = coe/10, exp+1
+	}
+	return coe, exp
+}
+
+// appendDigits appends coe × 10^exp with the integer and fraction digits of
+// the pattern, grouping the integer part into the given sizes.
+func (p Pattern) appendDigits(dst []byte, coe uint64, exp, primary, secondary int) []byte {
+	frac := max(-exp, 0)
+	showPoint := frac > 0 || p.minFrac > 0 || p.decimalShown
+
+	// A zero, or a number with nothing after it, keeps one integer digit.
+	minInt := p.minInt
+	if coe == 0 || !showPoint {
+		minInt = max(minInt, 1)
+	}
+
+	style := digitStyle{zero: '0', sep: firstRune(p.Locale.thousands), primary: primary, secondary: secondary}
+	if style.sep == 0 {
+		style.sep = ','
+	}
+	dst = appendInteger(dst, coe, exp, minInt, style)
+
+	if !showPoint {
+		return dst
+	}
+
+	decimal := firstRune(p.Locale.decimals)
+	if decimal == 0 {
+		decimal = '.'
+	}
+	dst = utf8.AppendRune(dst, decimal)
+	return appendFraction(dst, coe, exp, p.minFrac, '0')
+}
+
+// subpattern parses the prefix, number part and suffix at the start of s
+// and returns the text after them. The number part of a negative subpattern
+// is checked but otherwise ignored.
+func (p *Pattern) subpattern(s string, positive bool) (string, error) {
+	prefix, s, err := p.affix(s, positive)
+	if err != nil {
+		return "", err
+	}
+
+	if s == "" || !strings.ContainsRune("#0,.", rune(s[0])) {
+		return "", fmt.Errorf("missing number: %w", ErrInvalidPattern)
+	}
+
+	s, err = p.number(s, positive)
+	if err != nil {
+		return "", err
+	}
+
+	suffix, s, err := p.affix(s, positive)
+	if err != nil {
+		return "", err
+	}
+
+	p.prefix, p.suffix = prefix, suffix
+	return s, nil
+}
+
+// affix parses literal text up to the number part, a ';' or the end of s.
+func (p *Pattern) affix(s string, positive bool) (string, string, error) {
+	var b strings.Builder
+	for s != "" {
+		r, size := utf8.DecodeRuneInString(s)
+		switch {
+		case r == ';':
+			return b.String(), s, nil
+		case strings.ContainsRune("#0,.", r):
+			return b.String(), s, nil
+		case strings.ContainsRune("@*123456789", r):
+			return "", "", fmt.Errorf("unsupported %q: %w", r, ErrInvalidPattern)
+		case r == '\'':
+			end := strings.IndexByte(s[1:], '\'')
+			if end < 0 {
+				return "", "", fmt.Errorf("unterminated quote: %w", ErrInvalidPattern)
+			}
+			if end == 0 {
+				b.WriteByte('\'')
+			} else {
+				b.WriteString(s[1 : end+1])
+			}
+			size = end + 2
+		case r == '%' || r == '‰':
+			if positive {
+				p.scale = 2
+				if r == '‰' {
+					p.scale = 3
+				}
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+		s = s[size:]
+	}
+	return b.String(), "", nil
+}
+
+// number parses the number part at the start of s and returns the text
+// after it.
+func (p *Pattern) number(s string, positive bool) (string, error) {
+	var (
+		intHashes, intZeros int
+		fracZeros, fracHash int
+		fraction            bool
+		commas              int
+		group, prevGroup    int
+		minExp              int
+		expSign             bool
+		i                   int
+	)
+
+loop:
+	for ; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '#' && !fraction:
+			if intZeros > 0 {
+				return "", fmt.Errorf("'#' after '0': %w", ErrInvalidPattern)
+			}
+			intHashes++
+			group++
+		case c == '0' && !fraction:
+			intZeros++
+			group++
+		case c == ',' && !fraction:
+			commas++
+			prevGroup, group = group, 0
+		case c == '.' && !fraction:
+			fraction = true
+		case c == '0':
+			if fracHash > 0 {
+				return "", fmt.Errorf("'0' after '#': %w", ErrInvalidPattern)
+			}
+			fracZeros++
+		case c == '#':
+			fracHash++
+		case c == ',' || c == '.':
+			return "", fmt.Errorf("misplaced %q: %w", c, ErrInvalidPattern)
+		case c == 'E':
+			i++
+			if i < len(s) && s[i] == '+' {
+				expSign = true
+				i++
+			}
+			for ; i < len(s) && s[i] == '0'; i++ {
+				minExp++
+			}
+			if minExp == 0 {
+				return "", fmt.Errorf("missing exponent digits: %w", ErrInvalidPattern)
+			}
+			break loop
+		case '1' <= c && c <= '9', c == '@', c == '*':
+			return "", fmt.Errorf("unsupported %q: %w", c, ErrInvalidPattern)
+		default:
+			break loop
+		}
+	}
+
+	if intHashes+intZeros+fracZeros+fracHash == 0 {
+		return "", fmt.Errorf("missing digits: %w", ErrInvalidPattern)
+	}
+	if commas > 0 && group == 0 {
+		return "", fmt.Errorf("misplaced ',': %w", ErrInvalidPattern)
+	}
+
+	if positive {
+		p.minInt, p.maxInt = intZeros, intHashes+intZeros
+		p.minFrac, p.maxFrac = fracZeros, fracZeros+fracHash
+		p.decimalShown = fraction && p.maxFrac == 0
+		p.minExp, p.expSign = minExp, expSign
+		if commas > 0 {
+			p.primary = group
+			if commas > 1 && prevGroup != group {
+				p.secondary = prevGroup
+			}
+		}
+	}
+
+	return s[i:], nil
+}
@@ -0,0 +1,104 @@
+package fixedpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatPattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		value    string
+		expected string
+	}{
+		{"#,##0.00;(#,##0.00)", "1234567.891", "1,234,567.89"},
+		{"#,##0.00;(#,##0.00)", "-1234.5", "(1,234.50)"},
+		{"#,##0.00;(#,##0.00)", "0.005", "0.00"},
+		{"#,##0.00;(#,##0.00)", "0.015", "0.02"},
+		{"#,##0.00", "-12", "-12.00"},
+		{"0.###E0", "1234.5", "1.234E3"},
+		{"0.###E0", "0.00012", "1.2E-4"},
+		{"0.###E0", "0", "0E0"},
+		{"0.###E0", "-9.9996", "-1E1"},
+		{"00.00E+00", "12345", "12.34E+03"},
+		{"00.00E+00", "12346", "12.35E+03"},
+		{"0.00E0", "9.999", "1.00E1"},
+		{"##0.##E0", "12345", "12.3E3"},
+		{"##0.##E0", "0.00123", "1.23E-3"},
+		{"##0.##E0", "123456", "123E3"},
+		{"00000", "42", "00042"},
+		{"00000", "1234567", "1234567"},
+		{"00000", "41.5", "00042"},
+		{"#,##0.0 %", "0.1234", "12.3 %"},
+		{"#,##0.0 %", "12.5", "1,250.0 %"},
+		{"0.0‰", "0.01234", "12.3‰"},
+		{"#,##,##0", "123456789", "12,34,56,789"},
+		{"#", "0", "0"},
+		{"#.##", "0.5", ".5"},
+		{"#.##", "2", "2"},
+		{"#,##0.", "12", "12."},
+		{"0.0#", "1.5", "1.5"},
+		{"0.0#", "1.555", "1.56"},
+		{"0.0#", "3", "3.0"},
+		{"'#'0", "7", "#7"},
+		{"0 o''clock", "5", "5 o'clock"},
+		{"$#,##0.00;$-#,##0.00", "-5", "$-5.00"},
+		{"#,##0.00", "1E+5", "100,000.00"},
+		{"#,##0.00", "Infinity", "Infinity"},
+		{"#,##0.00;(#,##0.00)", "-Infinity", "(Infinity)"},
+		{"#,##0.00;(#,##0.00)", "NaN", "NaN"},
+		{"#,##0.00", "0E+3", "0.00"},
+		{"#,##0", "0E+5", "0"},
+		{"#", "0E+3", "0"},
+		{"#.00", "0", "0.00"},
+		{"00000", "0E+3", "00000"},
+		{"0.###E0", "0E+3", "0E0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"/"+tt.value, func(t *testing.T) {
+			p, err := ParsePattern(tt.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tt.pattern, p.String())
+			assert.Equal(t, tt.expected, FormatPattern(MustParse64(tt.value), p))
+		})
+	}
+}
+
+func TestFormatPatternOptions(t *testing.T) {
+	p := MustParsePattern("#,##0.00;(#,##0.00)")
+	p.Locale = LocaleDeDE
+	assert.Equal(t, "1.234.567,89", FormatPattern(MustParse64("1234567.891"), p))
+	assert.Equal(t, "(12,35)", FormatPattern(MustParse32("-12.349"), p))
+
+	p.Rounding = RoundTowardZero
+	assert.Equal(t, "(12,34)", FormatPattern(MustParse32("-12.349"), p))
+	assert.Equal(t, "x=1.234.567,89", string(AppendPattern([]byte("x="), MustParse64("1234567.899"), p)))
+}
+
+func TestParsePatternErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"abc",
+		"0#",
+		"0.#0",
+		"#,##0,",
+		"0.0.0",
+		"0.00,0",
+		"0E",
+		"#,##0.00;",
+		"0;0;0",
+		"0 #",
+		"@@@",
+		"#,##0.05",
+		"*x#0",
+		"'abc 0",
+	} {
+		_, err := ParsePattern(s)
+		assert.ErrorIs(t, err, ErrInvalidPattern, "ParsePattern(%q)", s)
+	}
+
+	assert.Panics(t, func() { MustParsePattern("0#") })
+}
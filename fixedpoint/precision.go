@@ -1,5 +1,20 @@
 package fixedpoint
 
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ encoding.TextMarshaler   = Precision(0)
+	_ encoding.TextUnmarshaler = (*Precision)(nil)
+	_ json.Marshaler           = Precision(0)
+	_ json.Unmarshaler         = (*Precision)(nil)
+)
+
 type Precision uint8 // Precision represents the number of significant digits in a FixedPoint value.
 
 const (
@@ -9,3 +24,51 @@ const (
 	PrecisionDefault64 Precision = 9
 	PrecisionMaximum64 Precision = 16
 )
+
+// String returns the number of digits in decimal.
+func (p Precision) String() string {
+	return strconv.Itoa(int(p))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, returning the
+// number of digits in decimal.
+func (p Precision) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(p), 10), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It accepts
+// a number of digits from PrecisionMinimum to PrecisionMaximum64.
+func (p *Precision) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil || Precision(n) < PrecisionMinimum || Precision(n) > PrecisionMaximum64 {
+		return fmt.Errorf("fixedpoint: precision %q: %w", s, ErrUnsupportedPrecision)
+	}
+
+	*p = Precision(n)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding the precision
+// as a JSON number.
+func (p Precision) MarshalJSON() ([]byte, error) {
+	return p.MarshalText()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting the
+// precision as a JSON number or string. Null leaves p unchanged.
+func (p *Precision) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) == 0 || data[0] != '"' {
+		return p.UnmarshalText(data)
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("fixedpoint: precision %s: %w", data, ErrUnsupportedPrecision)
+	}
+	return p.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,126 @@
+package fixedpoint
+
+import (
+	"bufio"
+	"io"
+	"unicode/utf8"
+)
+
+// Reader reads successive decimals from an io.Reader, by default as
+// whitespace-separated tokens. Tokens are parsed in place without
+// allocating. Successive calls to Scan step through the values; Scan stops
+// at the end of input or at the first token that is not a valid number.
+type Reader[X X64 | X32] struct {
+	scanner *bufio.Scanner
+	parse   func([]byte) (X, int, error)
+	value   X
+	err     error
+}
+
+// NewReader64 returns a Reader that parses X64 values with ctx.
+// If ctx is nil, a basic context is used.
+func NewReader64(r io.Reader, ctx *Context64) *Reader[X64] {
+	if ctx == nil {
+		ctx = BasicContext64()
+	}
+
+	return newReader(r, func(b []byte) (X64, int, error) {
+		return ctx.build(scanNumber(b, ctx.locale, ctx.options))
+	})
+}
+
+// NewReader32 returns a Reader that parses X32 values with ctx.
+// If ctx is nil, a basic context is used.
+func NewReader32(r io.Reader, ctx *Context32) *Reader[X32] {
+	if ctx == nil {
+		ctx = BasicContext32()
+	}
+
+	return newReader(r, func(b []byte) (X32, int, error) {
+		return ctx.build(scanNumber(b, ctx.locale, ctx.options))
+	})
+}
+
+func newReader[X X64 | X32](r io.Reader, parse func([]byte) (X, int, error)) *Reader[X] {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+
+	return &Reader[X]{
+		scanner: scanner,
+		parse:   parse,
+	}
+}
+
+// Split sets the function that splits the input into tokens, such as
+// bufio.ScanLines or SplitFields. It must be called before Scan.
+func (r *Reader[X]) Split(split bufio.SplitFunc) {
+	r.scanner.Split(split)
+}
+
+// Scan advances to the next value, which is then available from Value.
+// It returns false at the end of input or on an error, reported by Err.
+func (r *Reader[X]) Scan() bool {
+	if r.err != nil {
+		return false
+	}
+
+	if !r.scanner.Scan() {
+		r.err = r.scanner.Err()
+		return false
+	}
+
+	token := r.scanner.Bytes()
+	x, off, err := r.parse(token)
+	if err != nil {
+		r.err = &ParseError{Input: string(token), Offset: off, Err: err}
+		return false
+	}
+
+	r.value = x
+	return true
+}
+
+// Value returns the value read by the most recent call to Scan.
+func (r *Reader[X]) Value() X {
+	return r.value
+}
+
+// Err returns the first error encountered, or nil at a clean end of input.
+// Invalid tokens are reported as a *ParseError.
+func (r *Reader[X]) Err() error {
+	return r.err
+}
+
+// SplitFields returns a bufio.SplitFunc that splits the input into fields
+// separated by sep or by line breaks, as in simple CSV data. Quoted fields
+// are not supported.
+//
+// An empty field, as in "1,,2", is returned as an empty token. It is not a
+// number, so Reader.Scan stops there and Err reports a *ParseError wrapping
+// ErrEmpty; the values after it are not read.
+func SplitFields(sep rune) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		for i := 0; i < len(data); {
+			if !atEOF && !utf8.FullRune(data[i:]) {
+				return 0, nil, nil
+			}
+
+			r, n := utf8.DecodeRune(data[i:])
+			switch r {
+			case sep:
+				return i + n, data[:i], nil
+			case '\n':
+				if i > 0 && data[i-1] == '\r' {
+					return i + n, data[:i-1], nil
+				}
+				return i + n, data[:i], nil
+			}
+			i += n
+		}
+
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
@@ -0,0 +1,146 @@
+package fixedpoint
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readAll[X X64 | X32](r *Reader[X]) []string {
+	var values []string
+	for r.Scan() {
+		values = append(values, fmt.Sprint(r.Value()))
+	}
+	return values
+}
+
+func TestReader(t *testing.T) {
+	r := NewReader64(strings.NewReader(" 1.5 -2e3\n\tNaN  0.001 \n"), nil)
+	assert.Equal(t, []string{"1.5", "-2E+3", "NaN", "0.001"}, readAll(r))
+	assert.NoError(t, r.Err())
+	assert.False(t, r.Scan())
+}
+
+func TestReaderFields(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"1,2,3", []string{"1", "2", "3"}},
+		{"1.5,-2\r\n3, 4\n", []string{"1.5", "-2", "3", "4"}},
+		{"7;8", []string{"7", "8"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r := NewReader32(strings.NewReader(tt.input), nil)
+			sep := ','
+			if strings.ContainsRune(tt.input, ';') {
+				sep = ';'
+			}
+			r.Split(SplitFields(sep))
+
+			assert.Equal(t, tt.expected, readAll(r))
+			assert.NoError(t, r.Err())
+		})
+	}
+}
+
+func TestSplitFieldsMultibyte(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader("1¦2¦3"))
+	scanner.Buffer(make([]byte, 2), 16)
+	scanner.Split(SplitFields('¦'))
+
+	var fields []string
+	for scanner.Scan() {
+		fields = append(fields, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []string{"1", "2", "3"}, fields)
+}
+
+func TestReaderError(t *testing.T) {
+	r := NewReader64(strings.NewReader("1 2 3x4 5"), nil)
+	assert.Equal(t, []string{"1", "2"}, readAll(r))
+
+	var perr *ParseError
+	require.ErrorAs(t, r.Err(), &perr)
+	assert.Equal(t, "3x4", perr.Input)
+	assert.Equal(t, 1, perr.Offset)
+	assert.ErrorIs(t, r.Err(), ErrInvalidDigit)
+
+	r = NewReader64(strings.NewReader("1,,2"), nil)
+	r.Split(SplitFields(','))
+	assert.Equal(t, []string{"1"}, readAll(r))
+	assert.ErrorIs(t, r.Err(), ErrEmpty)
+
+	r = NewReader64(io.MultiReader(strings.NewReader("1 "), errReader{}), nil)
+	assert.Equal(t, []string{"1"}, readAll(r))
+	assert.ErrorIs(t, r.Err(), errRead)
+}
+
+func TestReaderEmptyField(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"1,,2", []string{"1"}},
+		{",1", nil},
+		{"1,2,\n3", []string{"1", "2"}},
+		{"1\r\n\r\n2", []string{"1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r := NewReader64(strings.NewReader(tt.input), nil)
+			r.Split(SplitFields(','))
+			assert.Equal(t, tt.expected, readAll(r))
+
+			var perr *ParseError
+			require.ErrorAs(t, r.Err(), &perr)
+			assert.Equal(t, "", perr.Input)
+			assert.Equal(t, 0, perr.Offset)
+			assert.ErrorIs(t, r.Err(), ErrEmpty)
+
+			assert.False(t, r.Scan(), "Scan continued after an empty field")
+		})
+	}
+}
+
+var errRead = errors.New("read failed")
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errRead
+}
+
+func TestParseBytes(t *testing.T) {
+	ctx := BasicContext64()
+	assert.Equal(t, "X64{-, 123456789, -3}", ctx.ParseBytes([]byte("-123,456.789")).Debug())
+	assert.Equal(t, "X64{sNaN, +, 12}", ctx.ParseBytes([]byte(" sNaN12 ")).Debug())
+	assert.Zero(t, ctx.signals)
+
+	ctx.ParseBytes([]byte("1..2"))
+	assert.NotZero(t, ctx.signals&SignalConversionSyntax)
+
+	assert.Equal(t, "X32{+, 15, -1}", BasicContext32().ParseBytes([]byte("1.5")).Debug())
+}
+
+func TestParseAllocations(t *testing.T) {
+	ctx := BasicContext64()
+	ctx.SetParseOptions(ParseUnicode)
+	inputs := []string{"-1,234,567.890", "  1.5E-3 ", "NaN123", "−１２３", "(12.50)", "123456789012345678901234567890"}
+
+	for _, s := range inputs {
+		b := []byte(s)
+		assert.Zero(t, testing.AllocsPerRun(100, func() { ctx.Parse(s) }), "Parse(%q)", s)
+		assert.Zero(t, testing.AllocsPerRun(100, func() { ctx.ParseBytes(b) }), "ParseBytes(%q)", s)
+	}
+}
@@ -2,7 +2,14 @@
 package fixedpoint
 
 import (
+	"encoding"
 	"fmt"
+	"strings"
+)
+
+var (
+	_ encoding.TextMarshaler   = Rounding(0)
+	_ encoding.TextUnmarshaler = (*Rounding)(nil)
 )
 
 // Rounding defines the rounding modes according to IEEE 754-2008
@@ -73,6 +80,32 @@ func (r Rounding) Debug() string {
 	}
 }
 
+// MarshalText implements the encoding.TextMarshaler interface, returning the
+// String form of r, such as "RoundTiesToEven".
+func (r Rounding) MarshalText() ([]byte, error) {
+	if r < DefaultRoundingMode || r > MaxRoundingMode {
+		return nil, fmt.Errorf("fixedpoint: %s: %w", r, ErrUnknownRounding)
+	}
+
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It accepts
+// the String form, with or without the "Round" prefix and in any case, such
+// as "RoundTiesToEven" or "towardzero", and the symbols of Debug, such as "TiE".
+func (r *Rounding) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	for mode := DefaultRoundingMode; mode <= MaxRoundingMode; mode++ {
+		name := mode.String()
+		if strings.EqualFold(s, name) || strings.EqualFold(s, strings.TrimPrefix(name, "Round")) || s == mode.Debug() {
+			*r = mode
+			return nil
+		}
+	}
+
+	return fmt.Errorf("fixedpoint: %q: %w", s, ErrUnknownRounding)
+}
+
 // apply applies the specified rounding mode to a coefficient to reduce it to the target precision.
 // It returns the rounded coefficient and the number of digits removed.
 func apply[E int8 | int16, C uint32 | uint64](mode Rounding, coef C, exp E, prec Precision, sign signc) (C, uint8) {
@@ -169,3 +202,167 @@ func powTen[T uint32 | uint64](n uint) T {
 	}
 	return result
 }
+
+// remainder classifies the digits discarded by rounding relative to
+// one half of a unit in the last retained place.
+type remainder uint8
+
+const (
+	rem_zero      remainder = iota // Nothing discarded; the result is exact
+	rem_belowHalf                  // Discarded digits are less than one half
+	rem_half                       // Discarded digits are exactly one half
+	rem_aboveHalf                  // Discarded digits are more than one half
+)
+
+// roundUp reports whether a coefficient truncated toward zero must be
+// incremented to honour the rounding mode, given the parity of the truncated
+// coefficient and the classification of the discarded digits.
+func roundUp(mode Rounding, sign signc, odd bool, rem remainder) bool {
+	if rem == rem_zero {
+		return false
+	}
+
+	switch mode {
+	case RoundTiesToEven:
+		return rem == rem_aboveHalf || (rem == rem_half && odd)
+	case RoundTiesToAway:
+		return rem >= rem_half
+	case RoundTowardPositive:
+		return sign == signc_positive
+	case RoundTowardNegative:
+		return sign == signc_negative
+	default:
+		return false
+	}
+}
+
+// shiftRight divides coe by 10^n, truncating toward zero, and classifies the
+// discarded digits. sticky reports that nonzero digits below the last digit of
+// coe were already discarded by the caller.
+func shiftRight(coe uint64, n int, sticky bool) (uint64, remainder) {
+	if n <= 0 {
+		if sticky {
+			return coe, rem_belowHalf
+		}
+		return coe, rem_zero
+	}
+
+	// Every uint64 is below half of 10^20, so all of it is discarded.
+	if n >= 20 {
+		if coe == 0 && !sticky {
+			return 0, rem_zero
+		}
+		return 0, rem_belowHalf
+	}
+
+	divisor := pow10[uint64](uint(n))
+	quotient, rest := coe/divisor, coe%divisor
+	half := divisor / 2
+
+	switch {
+	case rest == 0 && !sticky:
+		return quotient, rem_zero
+	case rest < half:
+		return quotient, rem_belowHalf
+	case rest == half && !sticky:
+		return quotient, rem_half
+	default:
+		return quotient, rem_aboveHalf
+	}
+}
+
+// round fits a finite coefficient and exponent into the context precision and
+// the exponent range of the format described by l. It implements the rounding,
+// clamping, overflow and underflow rules of IEEE 754-2008 and raises the
+// corresponding signals on the context.
+//
+// sticky reports that nonzero digits below the last digit of coe were already
+// discarded; callers setting it must supply more digits than the precision so
+// that the rounding digit is still present in coe.
+func (ctx *context) round(sign signc, coe uint64, exp int, sticky bool, l limits) (kind, uint64, int) {
+	prec := int(min(ctx.precision, l.precision))
+
+	// Zero is exact at any exponent; only the range needs enforcing.
+	if coe == 0 && !sticky {
+		return kind_finite, 0, min(max(exp, l.eTiny), l.eTop)
+	}
+
+	// Keep the coefficient below 10^19 so every power of ten used fits a uint64.
+	if coe >= pow10[uint64](19) {
+		sticky = sticky || coe%10 != 0
+		coe /= 10
+		exp++
+	}
+
+	// Remove digits beyond the precision, and any that would take the
+	// exponent below the smallest subnormal exponent.
+	drop := max(int(countDigits(coe))-prec, l.eTiny-exp, 0)
+	quotient, rem := shiftRight(coe, drop, sticky)
+	if roundUp(ctx.rounding, sign, quotient&1 == 1, rem) {
+		quotient++
+	}
+	coe, exp = quotient, exp+drop
+
+	// A carry out of the most significant digit leaves a trailing zero.
+	if coe == pow10[uint64](uint(prec)) {
+		coe /= 10
+		exp++
+	}
+
+	if drop > 0 {
+		ctx.signals |= SignalRounding
+	}
+	if rem != rem_zero {
+		ctx.signals |= SignalInexact | SignalRounding
+	}
+
+	if coe == 0 {
+		if rem != rem_zero {
+			ctx.signals |= SignalUnderflow
+		}
+		return kind_finite, 0, min(exp, l.eTop)
+	}
+
+	adjusted := exp + int(countDigits(coe)) - 1
+	if adjusted > l.eMax {
+		ctx.signals |= SignalOverflow | SignalInexact | SignalRounding
+		if !overflowToInfinity(ctx.rounding, sign) {
+			return kind_finite, maxCoefficient(l, prec), l.eTop
+		}
+		return kind_infinity, 0, 0
+	}
+
+	if adjusted < l.eMin && rem != rem_zero {
+		ctx.signals |= SignalUnderflow
+	}
+
+	// Clamp large exponents by padding the coefficient with zeros.
+	if exp > l.eTop {
+		coe *= pow10[uint64](uint(exp - l.eTop))
+		exp = l.eTop
+	}
+
+	return kind_finite, coe, exp
+}
+
+// overflowToInfinity reports whether an overflowing result is rounded to
+// infinity rather than to the largest finite magnitude.
+func overflowToInfinity(mode Rounding, sign signc) bool {
+	switch mode {
+	case RoundTowardZero:
+		return false
+	case RoundTowardPositive:
+		return sign == signc_positive
+	case RoundTowardNegative:
+		return sign == signc_negative
+	default:
+		return true
+	}
+}
+
+// maxCoefficient returns the coefficient of the largest finite value with
+// prec significant digits, expressed at the largest exponent of l.
+func maxCoefficient(l limits, prec int) uint64 {
+	coe := pow10[uint64](uint(prec)) - 1
+	return coe * pow10[uint64](uint(l.precision)-uint(prec))
+}
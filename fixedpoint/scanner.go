@@ -0,0 +1,104 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"io"
+)
+
+// Scannable returns a fmt.Scanner that reads a decimal into x, so that
+// fmt.Sscan, fmt.Fscanf and friends can read X64 and X32 values:
+//
+//	var price fixedpoint.X64
+//	_, err := fmt.Sscan("1.25E+3", fixedpoint.Scannable(&price))
+//
+// X64 and X32 cannot implement fmt.Scanner themselves, because their Scan
+// method implements sql.Scanner.
+//
+// The scanner accepts the verbs %v, %s, %f, %F, %e, %E, %g and %G. It reads a
+// token of ASCII letters, digits, signs, '.' and '_' and converts it with
+// ParseChecked on a clone of DefaultContext64 or DefaultContext32, so the
+// grammar, including exponents and special values, is that of Parse. Use
+// Context64.Scannable or Context32.Scannable to scan with another context.
+// Grouping with ',' is not supported, as ',' often separates the values.
+//
+// A token that does not parse is reported as the *ParseError from
+// ParseChecked, returned unwrapped by the fmt scanning functions. By then fmt
+// has consumed the whole token, so scanning resumes after it rather than at
+// the bad character. On error x is left unchanged. If x is nil, scanning
+// fails with an error before reading any input.
+func Scannable[X X64 | X32](x *X) fmt.Scanner {
+	switch x := any(x).(type) {
+	case *X64:
+		return scannable[X64]{x, func(s string) (X64, error) {
+			return defaultContext64().ParseChecked(s)
+		}}
+	case *X32:
+		return scannable[X32]{x, func(s string) (X32, error) {
+			return defaultContext32().ParseChecked(s)
+		}}
+	}
+	panic("unreachable")
+}
+
+// Scannable returns a fmt.Scanner that reads a decimal into x, converting it
+// with ParseChecked on a clone of ctx. If ctx is nil, DefaultContext64 is
+// used. See the Scannable function for details.
+func (ctx *Context64) Scannable(x *X64) fmt.Scanner {
+	return scannable[X64]{x, func(s string) (X64, error) {
+		return cleanContext64(ctx).ParseChecked(s)
+	}}
+}
+
+// Scannable returns a fmt.Scanner that reads a decimal into x, converting it
+// with ParseChecked on a clone of ctx. If ctx is nil, DefaultContext32 is
+// used. See the Scannable function for details.
+func (ctx *Context32) Scannable(x *X32) fmt.Scanner {
+	return scannable[X32]{x, func(s string) (X32, error) {
+		return cleanContext32(ctx).ParseChecked(s)
+	}}
+}
+
+type scannable[X X64 | X32] struct {
+	x     *X
+	parse func(string) (X, error)
+}
+
+// Scan implements the fmt.Scanner interface.
+func (s scannable[X]) Scan(state fmt.ScanState, verb rune) error {
+	if s.x == nil {
+		return fmt.Errorf("fixedpoint: cannot scan into nil *%T", *new(X))
+	}
+
+	switch verb {
+	case 'v', 's', 'f', 'F', 'e', 'E', 'g', 'G':
+	default:
+		return fmt.Errorf("fixedpoint: bad verb '%%%c' for %T", verb, *s.x)
+	}
+
+	tok, err := state.Token(true, isScanRune)
+	if err != nil {
+		return err
+	}
+	if len(tok) == 0 {
+		return io.ErrUnexpectedEOF
+	}
+
+	res, err := s.parse(string(tok))
+	if err != nil {
+		return err
+	}
+
+	*s.x = res
+	return nil
+}
+
+// isScanRune reports whether r may be part of a scanned number.
+func isScanRune(r rune) bool {
+	switch {
+	case '0' <= r && r <= '9', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		return true
+	case r == '+', r == '-', r == '.', r == '_':
+		return true
+	}
+	return false
+}
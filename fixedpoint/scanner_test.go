@@ -0,0 +1,149 @@
+package fixedpoint
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScannable(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"123.45", "123.45"},
+		{"  -0.001\n", "-0.001"},
+		{"1.25E+3", "1.25E+3"},
+		{"5e-3", "0.005"},
+		{"1_000", "1000"},
+		{"-Infinity", "-Infinity"},
+		{"nan", "NaN"},
+		{"sNaN12", "sNaN12"},
+		{"1.5abc", ""},
+		{"1..2", ""},
+		{"", ""},
+		{"1E+999", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			x := MustParse64("7")
+			_, err := fmt.Sscan(tt.input, Scannable(&x))
+			if tt.expected == "" {
+				assert.Error(t, err)
+				assert.Equal(t, "7", x.String())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, x.ToSciString())
+
+			var y X32
+			_, err = fmt.Sscan(tt.input, Scannable(&y))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, y.ToSciString())
+		})
+	}
+}
+
+func TestScannableErrors(t *testing.T) {
+	var x X64
+
+	_, err := fmt.Sscan("1.5x", Scannable(&x))
+	var perr *ParseError
+	require.ErrorAs(t, err, &perr)
+	assert.Equal(t, "1.5x", perr.Input)
+	assert.ErrorIs(t, err, ErrInvalidDigit)
+	assert.Equal(t, X64{}, x)
+
+	r := strings.NewReader("1.2.3 4")
+	_, err = fmt.Fscan(r, Scannable(&x))
+	require.ErrorAs(t, err, &perr)
+	assert.Equal(t, "1.2.3", perr.Input)
+	_, err = fmt.Fscan(r, Scannable(&x))
+	require.NoError(t, err)
+	assert.Equal(t, "4", x.String())
+
+	_, err = fmt.Sscan("1E+999", Scannable(&x))
+	assert.ErrorIs(t, err, ErrOutOfRange)
+
+	_, err = fmt.Sscan("", Scannable(&x))
+	assert.True(t, errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF), "%v", err)
+
+	_, err = fmt.Sscanf("1.5", "%d", Scannable(&x))
+	assert.ErrorContains(t, err, "bad verb '%d' for fixedpoint.X64")
+}
+
+func TestScannableFscan(t *testing.T) {
+	r := strings.NewReader("apples 1.25 3\npears -0.5E+2 12\n")
+
+	var (
+		name  string
+		price X64
+		qty   X32
+		names []string
+		lines []string
+	)
+	for {
+		_, err := fmt.Fscanln(r, &name, Scannable(&price), Scannable(&qty))
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, name)
+		lines = append(lines, price.ToSciString()+" x "+qty.String())
+	}
+
+	assert.Equal(t, []string{"apples", "pears"}, names)
+	assert.Equal(t, []string{"1.25 x 3", "-5E+1 x 12"}, lines)
+
+	var a, b X64
+	_, err := fmt.Sscanf("1.5,2.25", "%v,%g", Scannable(&a), Scannable(&b))
+	require.NoError(t, err)
+	assert.Equal(t, "1.5", a.String())
+	assert.Equal(t, "2.25", b.String())
+}
+
+func TestScannableNil(t *testing.T) {
+	r := strings.NewReader("1.5 2")
+	_, err := fmt.Fscan(r, Scannable[X64](nil))
+	assert.ErrorContains(t, err, "nil *fixedpoint.X64")
+
+	var ctx *Context32
+	_, err = fmt.Fscan(r, ctx.Scannable(nil))
+	assert.ErrorContains(t, err, "nil *fixedpoint.X32")
+
+	var x X64
+	_, err = fmt.Fscan(r, Scannable(&x))
+	require.NoError(t, err)
+	assert.Equal(t, "1.5", x.String())
+}
+
+func TestScannableContext(t *testing.T) {
+	ctx64, err := NewContext64(PrecisionMinimum, RoundTowardZero, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	var x X64
+	_, err = fmt.Sscan("1.2345", ctx64.Scannable(&x))
+	require.NoError(t, err)
+	assert.Equal(t, "X64{+, 123, -2}", x.Debug())
+	assert.Equal(t, SignalClear, ctx64.Signal(), "context must not collect signals")
+
+	ctx32, err := NewContext32(PrecisionMinimum, RoundTowardPositive, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+
+	var y X32
+	_, err = fmt.Sscan("1.231 x", ctx32.Scannable(&y))
+	require.NoError(t, err)
+	assert.Equal(t, "1.24", y.String())
+
+	var nilCtx *Context64
+	_, err = fmt.Sscan("1.2345", nilCtx.Scannable(&x))
+	require.NoError(t, err)
+	assert.Equal(t, "X64{+, 12345, -4}", x.Debug())
+}
@@ -1,6 +1,18 @@
 package fixedpoint
 
-import "strings"
+import (
+	"encoding"
+	"fmt"
+	"strings"
+)
+
+var (
+	_ encoding.TextMarshaler   = Signal(0)
+	_ encoding.TextUnmarshaler = (*Signal)(nil)
+)
+
+// ErrUnknownSignal is returned when text does not name a set of signals.
+var ErrUnknownSignal = fmt.Errorf("unknown signal")
 
 type Signal uint8
 
@@ -52,7 +64,7 @@ var stringFlags = []struct {
 func (s Signal) Debug() string {
 	var signals []string
 	for _, f := range debugFlags {
-		if s&f.flag != 0 {
+		if s&f.flag == f.flag {
 			signals = append(signals, f.symbol)
 		}
 	}
@@ -68,9 +80,65 @@ func (s Signal) Debug() string {
 func (s Signal) String() string {
 	var signals []string
 	for _, f := range stringFlags {
-		if s&f.flag != 0 {
+		if s&f.flag == f.flag {
 			signals = append(signals, f.name)
 		}
 	}
 	return strings.Join(signals, "|")
 }
+
+// MarshalText implements the encoding.TextMarshaler interface, returning the
+// String form of s, such as "SignalOverflow|SignalInexact".
+func (s Signal) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It accepts
+// signal names separated by '|', with or without the "Signal" prefix and in
+// any case, such as "SignalOverflow|inexact", or the symbols of Debug, such
+// as "oi". An empty string or "*" is SignalClear.
+func (s *Signal) UnmarshalText(text []byte) error {
+	var res Signal
+	for _, part := range strings.Split(string(text), "|") {
+		part = strings.TrimSpace(part)
+		if part == "" || part == "*" {
+			continue
+		}
+
+		flag, ok := parseSignal(part)
+		if !ok {
+			return fmt.Errorf("fixedpoint: %q: %w", part, ErrUnknownSignal)
+		}
+		res |= flag
+	}
+
+	*s = res
+	return nil
+}
+
+// parseSignal converts a signal name or a string of Debug symbols.
+func parseSignal(s string) (Signal, bool) {
+	for _, f := range stringFlags {
+		if strings.EqualFold(s, f.name) || strings.EqualFold(s, strings.TrimPrefix(f.name, "Signal")) {
+			return f.flag, true
+		}
+	}
+	if strings.EqualFold(s, "SignalClear") || strings.EqualFold(s, "Clear") {
+		return SignalClear, true
+	}
+
+	var res Signal
+	for _, c := range s {
+		found := false
+		for _, f := range debugFlags {
+			if string(c) == f.symbol {
+				res |= f.flag
+				found = true
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return res, true
+}
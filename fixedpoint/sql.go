@@ -0,0 +1,206 @@
+package fixedpoint
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+var (
+	_ sql.Scanner   = (*X64)(nil)
+	_ driver.Valuer = X64{}
+	_ sql.Scanner   = (*X32)(nil)
+	_ driver.Valuer = X32{}
+	_ sql.Scanner   = (*NullX64)(nil)
+	_ driver.Valuer = NullX64{}
+	_ sql.Scanner   = (*NullX32)(nil)
+	_ driver.Valuer = NullX32{}
+)
+
+// NullX64 represents an X64 that may be null. It implements sql.Scanner
+// and driver.Valuer so it can be used for nullable columns. Set Context to
+// scan with a context of your own instead of DefaultContext64.
+type NullX64 struct {
+	X64     X64
+	Valid   bool       // Valid is true if X64 is not NULL
+	Context *Context64 // Context converts scanned values; nil uses DefaultContext64
+}
+
+// NullX32 represents an X32 that may be null. It implements sql.Scanner
+// and driver.Valuer so it can be used for nullable columns. Set Context to
+// scan with a context of your own instead of DefaultContext32.
+type NullX32 struct {
+	X32     X32
+	Valid   bool       // Valid is true if X32 is not NULL
+	Context *Context32 // Context converts scanned values; nil uses DefaultContext32
+}
+
+// Scan implements the sql.Scanner interface. It accepts string, []byte,
+// int64 and float64 sources, converting them with a clone of DefaultContext64.
+// Syntax errors and trapped signals are reported as errors, in which case
+// x is left unchanged. To scan with another context, use a NullX64 with
+// Context set.
+func (x *X64) Scan(src any) error {
+	return x.scan(src, nil)
+}
+
+// scan converts src into x with a clone of ctx, or of DefaultContext64 if
+// ctx is nil.
+func (x *X64) scan(src any, ctx *Context64) error {
+	if x == nil {
+		return newInternalError(nil, "nil receiver")
+	}
+
+	ctx = cleanContext64(ctx)
+
+	var res X64
+	switch v := src.(type) {
+	case string:
+		res = ctx.Parse(v)
+	case []byte:
+		res = ctx.Parse(string(v))
+	case int64:
+		res = ctx.FromInt64(v)
+	case float64:
+		res = ctx.FromFloat64(v)
+	case nil:
+		return fmt.Errorf("fixedpoint: cannot scan NULL into X64")
+	default:
+		return fmt.Errorf("fixedpoint: cannot scan %T into X64", src)
+	}
+
+	if err := scanError(src, &ctx.context); err != nil {
+		return err
+	}
+
+	*x = res
+	return nil
+}
+
+// Value implements the driver.Valuer interface. Finite values and
+// infinities are returned in their ToSciString form and quiet NaNs as "NaN".
+// Signaling NaNs cannot be stored and return ErrNotFinite.
+func (x X64) Value() (driver.Value, error) {
+	k, _, _, _, err := x.unpack()
+	if err != nil {
+		return nil, err
+	}
+
+	return value(k, x.ToSciString())
+}
+
+// Scan implements the sql.Scanner interface, converting with a clone of
+// DefaultContext32. See X64.Scan for details.
+func (x *X32) Scan(src any) error {
+	return x.scan(src, nil)
+}
+
+// scan converts src into x with a clone of ctx, or of DefaultContext32 if
+// ctx is nil.
+func (x *X32) scan(src any, ctx *Context32) error {
+	if x == nil {
+		return newInternalError(nil, "nil receiver")
+	}
+
+	ctx = cleanContext32(ctx)
+
+	var res X32
+	switch v := src.(type) {
+	case string:
+		res = ctx.Parse(v)
+	case []byte:
+		res = ctx.Parse(string(v))
+	case int64:
+		res = ctx.FromInt64(v)
+	case float64:
+		res = ctx.FromFloat64(v)
+	case nil:
+		return fmt.Errorf("fixedpoint: cannot scan NULL into X32")
+	default:
+		return fmt.Errorf("fixedpoint: cannot scan %T into X32", src)
+	}
+
+	if err := scanError(src, &ctx.context); err != nil {
+		return err
+	}
+
+	*x = res
+	return nil
+}
+
+// Value implements the driver.Valuer interface. See X64.Value for details.
+func (x X32) Value() (driver.Value, error) {
+	k, _, _, _, err := x.unpack()
+	if err != nil {
+		return nil, err
+	}
+
+	return value(k, x.ToSciString())
+}
+
+// Scan implements the sql.Scanner interface, converting with a clone of
+// n.Context. A NULL source sets Valid to false.
+func (n *NullX64) Scan(src any) error {
+	if src == nil {
+		n.X64, n.Valid = X64{}, false
+		return nil
+	}
+
+	err := n.X64.scan(src, n.Context)
+	n.Valid = err == nil
+	return err
+}
+
+// Value implements the driver.Valuer interface, returning nil when not Valid.
+func (n NullX64) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.X64.Value()
+}
+
+// Scan implements the sql.Scanner interface, converting with a clone of
+// n.Context. A NULL source sets Valid to false.
+func (n *NullX32) Scan(src any) error {
+	if src == nil {
+		n.X32, n.Valid = X32{}, false
+		return nil
+	}
+
+	err := n.X32.scan(src, n.Context)
+	n.Valid = err == nil
+	return err
+}
+
+// Value implements the driver.Valuer interface, returning nil when not Valid.
+func (n NullX32) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.X32.Value()
+}
+
+// scanError reports conversion syntax errors and trapped signals raised
+// while scanning src.
+func scanError(src any, ctx *context) error {
+	if ctx.signals&(ctx.traps|SignalConversionSyntax) == 0 {
+		return nil
+	}
+
+	if b, ok := src.([]byte); ok {
+		src = string(b)
+	}
+
+	return fmt.Errorf("fixedpoint: cannot scan %#v: %s", src, ctx.signals)
+}
+
+func value(k kind, s string) (driver.Value, error) {
+	switch k {
+	case kind_signaling:
+		return nil, ErrNotFinite
+	default:
+		return s, nil
+	}
+}
@@ -0,0 +1,145 @@
+package fixedpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestX64Scan(t *testing.T) {
+	tests := []struct {
+		name      string
+		src       any
+		expected  string
+		expectErr bool
+	}{
+		{"String", "-1234.56", "X64{-, 123456, -2}", false},
+		{"Bytes", []byte("0.0001"), "X64{+, 1, -4}", false},
+		{"Int64", int64(-42), "X64{-, 42, 0}", false},
+		{"Float64", 0.1, "X64{+, 1, -1}", false},
+		{"Infinity", "Infinity", "X64{Inf, +}", false},
+		{"Syntax", "12a", "", true},
+		{"Null", nil, "", true},
+		{"Unsupported", true, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := One64()
+			err := x.Scan(tt.src)
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, One64(), x, "destination must be unchanged on error")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, x.Debug())
+		})
+	}
+}
+
+func TestX64ScanDefaultContext(t *testing.T) {
+	saved := DefaultContext64
+	t.Cleanup(func() { DefaultContext64 = saved })
+
+	ctx, err := NewContext64(PrecisionMinimum, RoundTowardZero, BasicTraps, DefaultLocale)
+	require.NoError(t, err)
+	DefaultContext64 = ctx
+
+	var x X64
+	require.NoError(t, x.Scan("1.2345"))
+	assert.Equal(t, "X64{+, 123, -2}", x.Debug())
+	assert.Equal(t, SignalClear, ctx.Signal(), "template context must not collect signals")
+
+	ctx, err = NewContext64(PrecisionMinimum, RoundTowardZero, BasicTraps|SignalInexact, DefaultLocale)
+	require.NoError(t, err)
+	DefaultContext64 = ctx
+	assert.Error(t, x.Scan(int64(12345)))
+}
+
+func TestX64Value(t *testing.T) {
+	v, err := MustParse64("-1234.56").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "-1234.56", v)
+
+	v, err = NaN64().Value()
+	require.NoError(t, err)
+	assert.Equal(t, "NaN", v)
+
+	v, err = Inf64(-1).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "-Infinity", v)
+
+	_, err = newSpecial64(signc_positive, kind_signaling).Value()
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestX32ScanValue(t *testing.T) {
+	var x X32
+	require.NoError(t, x.Scan([]byte("98.765")))
+	assert.Equal(t, "X32{+, 98765, -3}", x.Debug())
+
+	v, err := x.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "98.765", v)
+
+	assert.Error(t, x.Scan("--1"))
+}
+
+func TestNullX64(t *testing.T) {
+	var n NullX64
+	require.NoError(t, n.Scan("12.5"))
+	assert.True(t, n.Valid)
+	assert.Equal(t, "12.5", n.X64.String())
+
+	v, err := n.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "12.5", v)
+
+	require.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+
+	v, err = n.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	assert.Error(t, n.Scan("x"))
+	assert.False(t, n.Valid)
+}
+
+func TestNullX32(t *testing.T) {
+	var n NullX32
+	require.NoError(t, n.Scan(int64(7)))
+	assert.True(t, n.Valid)
+	assert.Equal(t, "7", n.X32.String())
+
+	require.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+
+	v, err := n.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestNullScanContext(t *testing.T) {
+	ctx64, err := NewContext64(PrecisionMinimum, RoundTowardZero, BasicTraps, LocaleDeDE)
+	require.NoError(t, err)
+
+	n := NullX64{Context: ctx64}
+	require.NoError(t, n.Scan("1,2345"))
+	assert.True(t, n.Valid)
+	assert.Equal(t, "X64{+, 123, -2}", n.X64.Debug())
+	assert.Equal(t, SignalClear, ctx64.Signal(), "context must not collect signals")
+	assert.Same(t, ctx64, n.Context)
+
+	ctx32, err := NewContext32(PrecisionMinimum, RoundTowardZero, BasicTraps|SignalInexact, DefaultLocale)
+	require.NoError(t, err)
+
+	m := NullX32{Context: ctx32}
+	assert.Error(t, m.Scan(int64(12345)))
+	assert.False(t, m.Valid)
+	require.NoError(t, m.Scan(int64(123)))
+	assert.Equal(t, "123", m.X32.String())
+}
@@ -3,95 +3,42 @@ package fixedpoint
 import (
 	"fmt"
 	"strconv"
-	"strings"
 )
 
 // String implements the fmt.Stringer interface for X64.
-// It returns a human-readable representation of the X64 decimal floating-point number.
+// It returns the ToSciString form of x, such as "1.5", "1.20E+3" or "NaN".
 func (x X64) String() string {
+	var buf [32]byte
+	return string(x.AppendFormat(buf[:0], 'v', -1))
+}
+
+// AppendFormat appends x to dst, formatted according to fmt and prec, and
+// returns the extended buffer. It does not allocate if dst has room.
+//
+// The format fmt is one of 'f', 'e', 'E', 'g' or 'G', with the same meaning
+// as the corresponding verbs of Format, or 'v' for the String form. The
+// precision prec is the number of digits after the decimal point for 'f',
+// 'e' and 'E', or of significant digits for 'g' and 'G'; -1 shows all
+// digits of the coefficient. Rounding uses the rounding mode of
+// DefaultContext64.
+func (x X64) AppendFormat(dst []byte, fmt byte, prec int) []byte {
 	k, sign, exp, coe, err := x.unpack()
 	if err != nil {
-		return fmt.Sprintf("X64{ERROR: %v}", err)
-	}
-
-	switch k {
-	case kind_quiet:
-		if sign == signc_negative {
-			return "-qNaN"
-		}
-		return "qNaN"
-	case kind_signaling:
-		if sign == signc_negative {
-			return "-sNaN"
-		}
-		return "sNaN"
-	case kind_infinity:
-		if sign == signc_negative {
-			return "-Infinity"
-		}
-		return "Infinity"
-	}
-
-	// For finite numbers, handle the sign, coefficient, and exponent
-	signStr := ""
-	if sign == signc_negative {
-		signStr = "-"
+		return appendError(dst, "X64", err)
 	}
 
-	// If coefficient is zero, return "0"
-	if coe == 0 {
-		return signStr + "0"
-	}
-
-	coeStr := strconv.FormatUint(coe, 10)
+	return formatted{k, sign, int(exp), coe, defaultRounding64()}.append(dst, fmt, prec)
+}
 
-	// Apply scientific notation if the exponent is out of a reasonable range
-	absExp := exp
-	if absExp < 0 {
-		absExp = -absExp
+// AppendText implements the encoding.TextAppender interface, appending the
+// ToSciString form of x to b.
+func (x X64) AppendText(b []byte) ([]byte, error) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return b, err
 	}
 
-	if absExp > 6 {
-		// Scientific notation: c.ccc...e±exp
-		digits := len(coeStr)
-		adjExp := exp + int16(digits-1)
-
-		// Format the coefficient with decimal point
-		var formatted string
-		if digits > 1 {
-			formatted = coeStr[:1] + "." + coeStr[1:]
-		} else {
-			formatted = coeStr + ".0"
-		}
-
-		// Trim trailing zeros after decimal point, but keep at least one digit
-		parts := strings.Split(formatted, ".")
-		parts[1] = strings.TrimRight(parts[1], "0")
-		if parts[1] == "" {
-			parts[1] = "0"
-		}
-		formatted = parts[0] + "." + parts[1]
-
-		return fmt.Sprintf("%s%se%+d", signStr, formatted, adjExp)
-	}
-
-	// Regular decimal notation
-	if exp >= 0 {
-		// Positive exponent - append zeros
-		return signStr + coeStr + strings.Repeat("0", int(exp))
-	} else {
-		// Negative exponent - insert decimal point
-		absExp := int(-exp)
-		if absExp >= len(coeStr) {
-			// Need to prepend zeros: 0.000ccc
-			zeros := strings.Repeat("0", absExp-len(coeStr))
-			return signStr + "0." + zeros + coeStr
-		} else {
-			// Insert decimal point: cc.ccc
-			pos := len(coeStr) - absExp
-			return signStr + coeStr[:pos] + "." + coeStr[pos:]
-		}
-	}
+	return appendToString(b, k, sign, int(exp), coe, false), nil
 }
 
 // Debug returns a debug representation of the X64 value showing the internal components.
@@ -107,10 +54,15 @@ func (x X64) Debug() string {
 	}
 
 	switch k {
-	case kind_quiet:
-		return fmt.Sprintf("X64{qNaN, %c}", signChar)
-	case kind_signaling:
-		return fmt.Sprintf("X64{sNaN, %c}", signChar)
+	case kind_quiet, kind_signaling:
+		name := "qNaN"
+		if k == kind_signaling {
+			name = "sNaN"
+		}
+		if coe != 0 {
+			return fmt.Sprintf("X64{%s, %c, %d}", name, signChar, coe)
+		}
+		return fmt.Sprintf("X64{%s, %c}", name, signChar)
 	case kind_infinity:
 		return fmt.Sprintf("X64{Inf, %c}", signChar)
 	default:
@@ -119,113 +71,188 @@ func (x X64) Debug() string {
 }
 
 // String implements the fmt.Stringer interface for X32.
-// It returns a human-readable representation of the X32 decimal floating-point number.
+// It returns the ToSciString form of x, such as "1.5", "1.20E+3" or "NaN".
 func (x X32) String() string {
+	var buf [32]byte
+	return string(x.AppendFormat(buf[:0], 'v', -1))
+}
+
+// AppendFormat appends x to dst, formatted according to fmt and prec, and
+// returns the extended buffer. See X64.AppendFormat for details; rounding
+// uses the rounding mode of DefaultContext32.
+func (x X32) AppendFormat(dst []byte, fmt byte, prec int) []byte {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return appendError(dst, "X32", err)
+	}
+
+	return formatted{k, sign, int(exp), uint64(coe), defaultRounding32()}.append(dst, fmt, prec)
+}
+
+// AppendText implements the encoding.TextAppender interface, appending the
+// ToSciString form of x to b.
+func (x X32) AppendText(b []byte) ([]byte, error) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return b, err
+	}
+
+	return appendToString(b, k, sign, int(exp), uint64(coe), false), nil
+}
+
+// Debug returns a debug representation of the X32 value showing the internal components.
+func (x X32) Debug() string {
 	k, sign, exp, coe, err := x.unpack()
 	if err != nil {
 		return fmt.Sprintf("X32{ERROR: %v}", err)
 	}
 
+	signChar := '+'
+	if sign == signc_negative {
+		signChar = '-'
+	}
+
 	switch k {
-	case kind_quiet:
-		if sign == signc_negative {
-			return "-qNaN"
+	case kind_quiet, kind_signaling:
+		name := "qNaN"
+		if k == kind_signaling {
+			name = "sNaN"
 		}
-		return "qNaN"
-	case kind_signaling:
-		if sign == signc_negative {
-			return "-sNaN"
+		if coe != 0 {
+			return fmt.Sprintf("X32{%s, %c, %d}", name, signChar, coe)
 		}
-		return "sNaN"
+		return fmt.Sprintf("X32{%s, %c}", name, signChar)
 	case kind_infinity:
-		if sign == signc_negative {
-			return "-Infinity"
-		}
-		return "Infinity"
+		return fmt.Sprintf("X32{Inf, %c}", signChar)
+	default:
+		return fmt.Sprintf("X32{%c, %d, %d}", signChar, coe, exp)
 	}
+}
 
-	// For finite numbers, handle the sign, coefficient, and exponent
-	signStr := ""
-	if sign == signc_negative {
-		signStr = "-"
+// ToSciString returns x in the to-scientific-string form of the General
+// Decimal Arithmetic specification, such as "1.20E+3", "0.00123" or "NaN".
+// It preserves the exponent, so parsing the result at the maximum precision
+// yields exactly x. String returns the same form.
+func (x X64) ToSciString() string {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return fmt.Sprintf("X64{ERROR: %v}", err)
 	}
 
-	// If coefficient is zero, return "0"
-	if coe == 0 {
-		return signStr + "0"
+	var buf [32]byte
+	return string(appendToString(buf[:0], k, sign, int(exp), coe, false))
+}
+
+// ToSciString returns x in the to-scientific-string form of the General
+// Decimal Arithmetic specification. See X64.ToSciString for details.
+func (x X32) ToSciString() string {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return fmt.Sprintf("X32{ERROR: %v}", err)
 	}
 
-	coeStr := strconv.FormatUint(uint64(coe), 10)
+	var buf [32]byte
+	return string(appendToString(buf[:0], k, sign, int(exp), uint64(coe), false))
+}
 
-	// Apply scientific notation if the exponent is out of a reasonable range
-	absExp := exp
-	if absExp < 0 {
-		absExp = -absExp
+// appendToString appends the to-scientific-string form of a value to dst,
+// or the to-engineering-string form if eng is set.
+func appendToString(dst []byte, k kind, sign signc, exp int, coe uint64, eng bool) []byte {
+	if sign == signc_negative {
+		dst = append(dst, '-')
 	}
 
-	if absExp > 6 {
-		// Scientific notation: c.ccc...e±exp
-		digits := len(coeStr)
-		adjExp := exp + int8(digits-1)
-
-		// Format the coefficient with decimal point
-		var formatted string
-		if digits > 1 {
-			formatted = coeStr[:1] + "." + coeStr[1:]
-		} else {
-			formatted = coeStr + ".0"
+	switch k {
+	case kind_infinity:
+		return append(dst, "Infinity"...)
+	case kind_quiet, kind_signaling:
+		if k == kind_signaling {
+			dst = append(dst, 's')
+		}
+		dst = append(dst, "NaN"...)
+		if coe != 0 {
+			dst = strconv.AppendUint(dst, coe, 10)
 		}
+		return dst
+	}
+
+	var buf [20]byte
+	digits := strconv.AppendUint(buf[:0], coe, 10)
+
+	// Choose the number of digits before the decimal point. Plain notation
+	// is used when the exponent is not positive and the adjusted exponent
+	// is at least -6; otherwise the remaining exponent is written after
+	// an E, as a multiple of three in engineering notation.
+	left := exp + len(digits)
+	var point int
+	switch {
+	case exp <= 0 && left > -6:
+		point = left
+	case !eng:
+		point = 1
+	case coe == 0:
+		point = floorMod(left+1, 3) - 1
+	default:
+		point = floorMod(left-1, 3) + 1
+	}
 
-		// Trim trailing zeros after decimal point, but keep at least one digit
-		parts := strings.Split(formatted, ".")
-		parts[1] = strings.TrimRight(parts[1], "0")
-		if parts[1] == "" {
-			parts[1] = "0"
+	switch {
+	case point <= 0:
+		dst = append(dst, "0."...)
+		for i := point; i < 0; i++ {
+			dst = append(dst, '0')
+		}
+		dst = append(dst, digits...)
+	case point >= len(digits):
+		dst = append(dst, digits...)
+		for i := len(digits); i < point; i++ {
+			dst = append(dst, '0')
 		}
-		formatted = parts[0] + "." + parts[1]
-
-		return fmt.Sprintf("%s%se%+d", signStr, formatted, adjExp)
-	}
-
-	// Regular decimal notation
-	if exp >= 0 {
-		// Positive exponent - append zeros
-		return signStr + coeStr + strings.Repeat("0", int(exp))
-	} else {
-		// Negative exponent - insert decimal point
-		absExp := int(-exp)
-		if absExp >= len(coeStr) {
-			// Need to prepend zeros: 0.000ccc
-			zeros := strings.Repeat("0", absExp-len(coeStr))
-			return signStr + "0." + zeros + coeStr
-		} else {
-			// Insert decimal point: cc.ccc
-			pos := len(coeStr) - absExp
-			return signStr + coeStr[:pos] + "." + coeStr[pos:]
+	default:
+		dst = append(dst, digits[:point]...)
+		dst = append(dst, '.')
+		dst = append(dst, digits[point:]...)
+	}
+
+	if left != point {
+		dst = append(dst, 'E')
+		if left > point {
+			dst = append(dst, '+')
 		}
+		dst = strconv.AppendInt(dst, int64(left-point), 10)
 	}
+	return dst
 }
 
-// Debug returns a debug representation of the X32 value showing the internal components.
-func (x X32) Debug() string {
+// floorMod returns a modulo m with the sign of m.
+func floorMod(a, m int) int {
+	if r := a % m; r < 0 {
+		return r + m
+	}
+	return a % m
+}
+
+// ToEngString returns x in the to-engineering-string form of the General
+// Decimal Arithmetic specification: like ToSciString, but any exponent is a
+// multiple of three, as in "12.5E+3" or "450E-6".
+func (x X64) ToEngString() string {
 	k, sign, exp, coe, err := x.unpack()
 	if err != nil {
-		return fmt.Sprintf("X32{ERROR: %v}", err)
+		return fmt.Sprintf("X64{ERROR: %v}", err)
 	}
 
-	signChar := '+'
-	if sign == signc_negative {
-		signChar = '-'
-	}
+	var buf [32]byte
+	return string(appendToString(buf[:0], k, sign, int(exp), coe, true))
+}
 
-	switch k {
-	case kind_quiet:
-		return fmt.Sprintf("X32{qNaN, %c}", signChar)
-	case kind_signaling:
-		return fmt.Sprintf("X32{sNaN, %c}", signChar)
-	case kind_infinity:
-		return fmt.Sprintf("X32{Inf, %c}", signChar)
-	default:
-		return fmt.Sprintf("X32{%c, %d, %d}", signChar, coe, exp)
+// ToEngString returns x in the to-engineering-string form of the General
+// Decimal Arithmetic specification. See X64.ToEngString for details.
+func (x X32) ToEngString() string {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return fmt.Sprintf("X32{ERROR: %v}", err)
 	}
+
+	var buf [32]byte
+	return string(appendToString(buf[:0], k, sign, int(exp), uint64(coe), true))
 }
@@ -53,7 +53,7 @@ func TestX64String(t *testing.T) {
 				x.pack(kind_quiet, signc_positive, 0, 0)
 				return x
 			},
-			expected: "qNaN",
+			expected: "NaN",
 		},
 		{
 			name: "negative quiet NaN",
@@ -62,7 +62,7 @@ func TestX64String(t *testing.T) {
 				x.pack(kind_quiet, signc_negative, 0, 0)
 				return x
 			},
-			expected: "-qNaN",
+			expected: "-NaN",
 		},
 		{
 			name: "signaling NaN",
@@ -107,7 +107,7 @@ func TestX64String(t *testing.T) {
 				x.pack(kind_finite, signc_positive, 2, 789)
 				return x
 			},
-			expected: "78900",
+			expected: "7.89E+4",
 		},
 		{
 			name: "negative exponent with decimal",
@@ -134,7 +134,7 @@ func TestX64String(t *testing.T) {
 				x.pack(kind_finite, signc_positive, 10, 1234)
 				return x
 			},
-			expected: "1.234e+13",
+			expected: "1.234E+13",
 		},
 		{
 			name: "scientific notation for small exponent",
@@ -143,7 +143,7 @@ func TestX64String(t *testing.T) {
 				x.pack(kind_finite, signc_negative, -10, 5678)
 				return x
 			},
-			expected: "-5.678e-7",
+			expected: "-5.678E-7",
 		},
 	}
 
@@ -158,6 +158,35 @@ func TestX64String(t *testing.T) {
 	}
 }
 
+func TestX64StringCohort(t *testing.T) {
+	tests := []struct {
+		exp      int16
+		coe      uint64
+		expected string
+	}{
+		{1, 120, "1.20E+3"},
+		{0, 1200, "1200"},
+		{-2, 0, "0.00"},
+		{3, 0, "0E+3"},
+		{-7, 5, "5E-7"},
+		{20, 1, "1E+20"},
+		{-6, 1, "0.000001"},
+	}
+
+	for _, tt := range tests {
+		var x X64
+		if err := x.pack(kind_finite, signc_positive, tt.exp, tt.coe); err != nil {
+			t.Fatal(err)
+		}
+		if got := x.String(); got != tt.expected {
+			t.Errorf("%s.String() = %q, want %q", x.Debug(), got, tt.expected)
+		}
+		if got := x.ToSciString(); got != tt.expected {
+			t.Errorf("%s.ToSciString() = %q, want %q", x.Debug(), got, tt.expected)
+		}
+	}
+}
+
 func TestX32String(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -207,7 +236,7 @@ func TestX32String(t *testing.T) {
 				x.pack(kind_quiet, signc_positive, 0, 0)
 				return x
 			},
-			expected: "qNaN",
+			expected: "NaN",
 		},
 		{
 			name: "decimal with zero exponent",
@@ -225,7 +254,7 @@ func TestX32String(t *testing.T) {
 				x.pack(kind_finite, signc_positive, 3, 123)
 				return x
 			},
-			expected: "123000",
+			expected: "1.23E+5",
 		},
 		{
 			name: "decimal with negative exponent",
@@ -248,3 +277,213 @@ func TestX32String(t *testing.T) {
 		})
 	}
 }
+
+func TestToSciString(t *testing.T) {
+	tests := []struct {
+		neg      bool
+		coe      uint64
+		exp      int
+		expected string
+	}{
+		{false, 123, 0, "123"},
+		{true, 123, 0, "-123"},
+		{false, 123, 1, "1.23E+3"},
+		{false, 123, 3, "1.23E+5"},
+		{false, 123, -1, "12.3"},
+		{false, 123, -5, "0.00123"},
+		{false, 123, -10, "1.23E-8"},
+		{true, 123, -12, "-1.23E-10"},
+		{false, 0, 0, "0"},
+		{false, 0, -2, "0.00"},
+		{false, 0, 2, "0E+2"},
+		{true, 0, 0, "-0"},
+		{false, 5, -6, "0.000005"},
+		{false, 50, -7, "0.0000050"},
+		{false, 5, -7, "5E-7"},
+		{false, 120, 1, "1.20E+3"},
+		{false, 1200, 0, "1200"},
+		{false, 9999999999999999, 369, "9.999999999999999E+384"},
+		{true, 1, -398, "-1E-398"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			x, err := New64(tt.neg, tt.coe, tt.exp)
+			if err != nil {
+				t.Fatalf("New64 failed: %v", err)
+			}
+			if got := x.ToSciString(); got != tt.expected {
+				t.Errorf("X64.ToSciString() = %q, want %q", got, tt.expected)
+			}
+
+			if tt.coe > uint64(maxCoefficient32) || tt.exp < int(eTiny32) || tt.exp > int(eTop32) {
+				return
+			}
+			y, err := New32(tt.neg, uint32(tt.coe), tt.exp)
+			if err != nil {
+				t.Fatalf("New32 failed: %v", err)
+			}
+			if got := y.ToSciString(); got != tt.expected {
+				t.Errorf("X32.ToSciString() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToSciStringSpecials(t *testing.T) {
+	tests := []struct {
+		x        X64
+		expected string
+	}{
+		{Inf64(1), "Infinity"},
+		{Inf64(-1), "-Infinity"},
+		{NaN64(), "NaN"},
+		{newSpecial64(signc_negative, kind_signaling), "-sNaN"},
+		{MustParse64("qNaN123"), "NaN123"},
+		{MustParse64("-sNaN45"), "-sNaN45"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.x.ToSciString(); got != tt.expected {
+			t.Errorf("X64.ToSciString() = %q, want %q", got, tt.expected)
+		}
+	}
+
+	if got := MustParse32("-NaN7").ToSciString(); got != "-NaN7" {
+		t.Errorf("X32.ToSciString() = %q, want %q", got, "-NaN7")
+	}
+}
+
+func TestToEngString(t *testing.T) {
+	tests := []struct {
+		neg      bool
+		coe      uint64
+		exp      int
+		expected string
+	}{
+		{false, 123, 1, "1.23E+3"},
+		{false, 123, 3, "123E+3"},
+		{false, 123, -10, "12.3E-9"},
+		{true, 123, -12, "-123E-12"},
+		{false, 7, -7, "700E-9"},
+		{false, 7, 1, "70"},
+		{false, 125, 2, "12.5E+3"},
+		{false, 450, -6, "0.000450"},
+		{false, 45, -7, "0.0000045"},
+		{false, 45, -8, "450E-9"},
+		{false, 0, 1, "0.00E+3"},
+		{false, 0, 2, "0.0E+3"},
+		{false, 0, 3, "0E+3"},
+		{true, 0, -7, "-0.0E-6"},
+		{false, 0, -8, "0.00E-6"},
+		{false, 0, -2, "0.00"},
+		{false, 123, 0, "123"},
+		{false, 1, 369, "1E+369"},
+		{false, 12, 367, "120E+366"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			x, err := New64(tt.neg, tt.coe, tt.exp)
+			if err != nil {
+				t.Fatalf("New64 failed: %v", err)
+			}
+			if got := x.ToEngString(); got != tt.expected {
+				t.Errorf("X64.ToEngString() = %q, want %q", got, tt.expected)
+			}
+
+			if tt.exp < int(eTiny32) || tt.exp > int(eTop32) {
+				return
+			}
+			y, err := New32(tt.neg, uint32(tt.coe), tt.exp)
+			if err != nil {
+				t.Fatalf("New32 failed: %v", err)
+			}
+			if got := y.ToEngString(); got != tt.expected {
+				t.Errorf("X32.ToEngString() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	if got := Inf64(-1).ToEngString(); got != "-Infinity" {
+		t.Errorf("X64.ToEngString() = %q, want %q", got, "-Infinity")
+	}
+	if got := MustParse32("sNaN12").ToEngString(); got != "sNaN12" {
+		t.Errorf("X32.ToEngString() = %q, want %q", got, "sNaN12")
+	}
+}
+
+func TestAppendFormat(t *testing.T) {
+	tests := []struct {
+		value    string
+		fmt      byte
+		prec     int
+		expected string
+	}{
+		{"123.450", 'v', -1, "123.450"},
+		{"1E+10", 'v', -1, "1E+10"},
+		{"-123.456", 'f', 2, "-123.46"},
+		{"123.456", 'f', -1, "123.456"},
+		{"123.456", 'e', 3, "1.235e+02"},
+		{"0.000120", 'E', -1, "1.20E-04"},
+		{"1234567", 'g', -1, "1.234567e+06"},
+		{"-1.20", 'G', 5, "-1.2"},
+		{"0E+3", 'f', 2, "0.00"},
+		{"0E+3", 'f', -1, "0"},
+		{"0E+3", 'g', -1, "0"},
+		{"0E+3", 'v', -1, "0E+3"},
+		{"-Infinity", 'f', 2, "-Infinity"},
+		{"NaN", 'e', 2, "NaN"},
+		{"1.5", 'x', -1, "%x"},
+	}
+
+	for _, tt := range tests {
+		buf := []byte("x=")
+		if got := string(MustParse64(tt.value).AppendFormat(buf, tt.fmt, tt.prec)); got != "x="+tt.expected {
+			t.Errorf("X64(%s).AppendFormat(%q, %d) = %q, want %q", tt.value, tt.fmt, tt.prec, got, "x="+tt.expected)
+		}
+		if got := string(MustParse32(tt.value).AppendFormat(nil, tt.fmt, tt.prec)); got != tt.expected {
+			t.Errorf("X32(%s).AppendFormat(%q, %d) = %q, want %q", tt.value, tt.fmt, tt.prec, got, tt.expected)
+		}
+	}
+}
+
+func TestAppendText(t *testing.T) {
+	for _, s := range []string{"0", "-1.20E+3", "0.00123", "1E-101", "-Infinity", "sNaN12"} {
+		x := MustParse64(s)
+		got, err := x.AppendText([]byte("x="))
+		if err != nil || string(got) != "x="+x.ToSciString() {
+			t.Errorf("X64.AppendText() = %q, %v, want %q", got, err, "x="+x.ToSciString())
+		}
+
+		y := MustParse32(s)
+		got, err = y.AppendText(nil)
+		if err != nil || string(got) != y.ToSciString() {
+			t.Errorf("X32.AppendText() = %q, %v, want %q", got, err, y.ToSciString())
+		}
+	}
+}
+
+func TestAppendAllocations(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	for _, s := range []string{"-1234567.123456", "1.5E-90", "-Infinity", "NaN123"} {
+		x, y := MustParse64(s), MustParse32(s)
+
+		allocs := testing.AllocsPerRun(100, func() {
+			x.AppendFormat(buf, 'v', -1)
+			x.AppendFormat(buf, 'f', 2)
+			x.AppendFormat(buf, 'e', -1)
+			x.AppendFormat(buf, 'g', 5)
+			x.AppendText(buf)
+			y.AppendFormat(buf, 'v', -1)
+			y.AppendText(buf)
+		})
+		if allocs != 0 {
+			t.Errorf("Append(%q) allocated %v times, want 0", s, allocs)
+		}
+
+		if allocs := testing.AllocsPerRun(100, func() { _ = x.String() }); allocs > 1 {
+			t.Errorf("X64(%q).String() allocated %v times, want at most 1", s, allocs)
+		}
+	}
+}
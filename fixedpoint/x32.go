@@ -1,5 +1,7 @@
 package fixedpoint
 
+import "fmt"
+
 // X32 implements the IEEE 754-2008 decimal32 format
 // using Binary Integer Decimal (BID) encoding
 type X32 struct {
@@ -17,13 +19,68 @@ const (
 	// eMin32 is the minimum decoded exponent value (-Elimit/2)
 	eMin32 int8 = -95 // -191/2
 	// eTiny32 is the exponent of the smallest possible subnormal (Emin - (precision-1))
-	eTiny32 int16 = -101 // -95 - (7-1)
+	eTiny32 int8 = -101 // -95 - (7-1)
+	// eTop32 is the largest exponent that can be encoded (Emax - (precision-1))
+	eTop32 int8 = 90 // 96 - (7-1)
 	// bias32 is the value to add to decoded exponent to get encoded exponent (-Emin + precision - 1)
 	bias32 int16 = 101 // -(-95) + 7 - 1
 	// maxCoefficient32 is the maximum coefficient value (10^precision - 1)
 	maxCoefficient32 uint32 = 9999999 // 10^7 - 1
+	// maxPayload32 is the maximum NaN payload value (10^(precision-1) - 1)
+	maxPayload32 uint32 = 999999 // 10^6 - 1
 )
 
+// New32 returns the X32 with the value coefficient × 10^exponent,
+// negated when neg is set. It returns an error wrapping ErrOutOfRange when the
+// coefficient has more than 7 digits or the exponent cannot be encoded.
+func New32(neg bool, coefficient uint32, exponent int) (X32, error) {
+	if coefficient > maxCoefficient32 {
+		return X32{}, fmt.Errorf("coefficient %d: %w", coefficient, ErrOutOfRange)
+	}
+
+	if exponent < int(eTiny32) || exponent > int(eTop32) {
+		return X32{}, fmt.Errorf("exponent %d: %w", exponent, ErrOutOfRange)
+	}
+
+	var x X32
+	err := x.pack(kind_finite, signOf(neg), int8(exponent), coefficient)
+	return x, err
+}
+
+// Zero32 returns positive zero with an exponent of 0.
+func Zero32() X32 {
+	x, _ := New32(false, 0, 0)
+	return x
+}
+
+// One32 returns one with an exponent of 0.
+func One32() X32 {
+	x, _ := New32(false, 1, 0)
+	return x
+}
+
+// NaN32 returns a quiet NaN.
+func NaN32() X32 {
+	return newSpecial32(signc_positive, kind_quiet)
+}
+
+// Inf32 returns positive infinity if sign >= 0, negative infinity if sign < 0.
+func Inf32(sign int) X32 {
+	return newSpecial32(signOf(sign < 0), kind_infinity)
+}
+
+// Components returns the sign, coefficient, exponent and class of x.
+// For a NaN the coefficient is its payload; otherwise the coefficient and
+// exponent are zero unless x is finite.
+func (x X32) Components() (neg bool, coefficient uint32, exponent int, class Class) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return false, 0, 0, ClassSignalingNaN
+	}
+
+	return sign == signc_negative, coe, int(exp), Class(k)
+}
+
 // pack implements the packed interface by encoding components into BID format.
 // According to IEEE 754-2008, decimal32 has:
 // - 1 bit for sign
@@ -39,19 +96,12 @@ func (x *X32) pack(k kind, sign signc, exp int8, coe uint32) error {
 		return newInternalError(coe, "coefficient overflow")
 	}
 
-	if (exp > eMax32 || exp < eMin32) && k == kind_finite {
-		return newInternalError(exp, "exponent out of range")
+	if coe > maxPayload32 && (k == kind_quiet || k == kind_signaling) {
+		return newInternalError(coe, "payload overflow")
 	}
 
-	// Check for subnormal values (non-zero coefficient with minimum exponent)
-	// and return a signaling NaN immediately
-	if k == kind_finite && exp == eMin32 && coe > 0 {
-		// Set as signaling NaN
-		x.uint32 = 0x7E000000
-		if sign == signc_negative {
-			x.uint32 |= 1 << 31
-		}
-		return nil
+	if (exp > eTop32 || exp < eTiny32) && k == kind_finite {
+		return newInternalError(exp, "exponent out of range")
 	}
 
 	// Start with zero
@@ -68,22 +118,20 @@ func (x *X32) pack(k kind, sign signc, exp int8, coe uint32) error {
 		// Add bias to get encoded exponent
 		biasedExp := uint32(int16(exp) + bias32)
 
-		// Check if coefficient fits in 20 bits (2^20 = 1048576)
-		if coe < (1 << 20) {
-			// Normal format: G0..G10=eeeeeeeeeee, remaining bits are coefficient
+		// Check if coefficient fits in 23 bits (2^23 = 8388608)
+		if coe < (1 << 23) {
+			// Normal format: G0..G7=eeeeeeee, remaining bits are coefficient
 			// Exponent bits first (8 bits)
 			result |= (biasedExp & 0xFF) << 23
 			// Then coefficient bits
-			result |= coe & 0xFFFFF
+			result |= coe & 0x7FFFFF
 		} else {
 			// Large coefficient - need to use alternative encoding
-			// Set first 2 bits of exp in combination field
-			result |= ((biasedExp >> 6) & 0x3) << 29
 			// Set special pattern 11 to indicate this format
-			result |= 3 << 27
-			// Set remaining 6 bits of exponent
-			result |= (biasedExp & 0x3F) << 21
-			// Set coefficient bits
+			result |= 3 << 29
+			// Exponent bits follow the pattern (8 bits)
+			result |= (biasedExp & 0xFF) << 21
+			// Set coefficient bits; the leading 100 is implicit
 			result |= coe & 0x1FFFFF
 		}
 
@@ -93,11 +141,11 @@ func (x *X32) pack(k kind, sign signc, exp int8, coe uint32) error {
 
 	case kind_quiet:
 		// Quiet NaN: G0..G4=11111, G5=0
-		result |= 0x7C000000
+		result |= 0x7C000000 | coe
 
 	case kind_signaling:
 		// Signaling NaN: G0..G4=11111, G5=1
-		result |= 0x7E000000
+		result |= 0x7E000000 | coe
 
 	default:
 		return newInternalError(k, "invalid kind")
@@ -132,11 +180,17 @@ func (x *X32) unpack() (kind, signc, int8, uint32, error) {
 		// Positive or negative infinity
 		return kind_infinity, sign, 0, 0, nil
 	case 0x1F: // 11111
-		// NaN - determine if quiet or signaling using G5 bit
+		// NaN - the trailing bits hold the payload, treated as zero if non-canonical
+		payload := bits & 0xFFFFF
+		if payload > maxPayload32 {
+			payload = 0
+		}
+
+		// Determine if quiet or signaling using G5 bit
 		if (bits>>25)&0x1 == 1 {
-			return kind_signaling, sign, 0, 0, nil
+			return kind_signaling, sign, 0, payload, nil
 		}
-		return kind_quiet, sign, 0, 0, nil
+		return kind_quiet, sign, 0, payload, nil
 	}
 
 	// Handle normal values
@@ -147,13 +201,12 @@ func (x *X32) unpack() (kind, signc, int8, uint32, error) {
 	var coe uint32
 
 	if g0g1 == 0x3 { // Large coefficient format
-		// Extract encoded exponent: 2 bits in combination field + 6 bits in exponent continuation field
-		encodedExp := int16(((bits >> 29) & 0x3) << 6)
-		encodedExp |= int16((bits >> 21) & 0x3F)
+		// Extract encoded exponent: 8 bits after the 11 pattern
+		encodedExp := int16((bits >> 21) & 0xFF)
 		exp = int8(encodedExp - bias32) // Remove bias to get decoded exponent
 
-		// Extract coefficient
-		coe = bits & 0x1FFFFF
+		// Extract coefficient, restoring the implicit leading 100
+		coe = 1<<23 | bits&0x1FFFFF
 	} else {
 		// Normal format
 		// Extract encoded exponent: 8 bits after sign
@@ -161,7 +214,12 @@ func (x *X32) unpack() (kind, signc, int8, uint32, error) {
 		exp = int8(encodedExp - bias32) // Remove bias to get decoded exponent
 
 		// Extract coefficient
-		coe = bits & 0xFFFFF
+		coe = bits & 0x7FFFFF
+	}
+
+	// Non-canonical coefficients are treated as zero
+	if coe > maxCoefficient32 {
+		coe = 0
 	}
 
 	return kind_finite, sign, exp, coe, nil
@@ -224,8 +282,8 @@ func (x *X32) Round(mode Rounding, precision Precision) error {
 	}
 
 	// For special cases of subnormal or extreme values
-	if exp < eMin32 || exp > eMax32 {
-		if exp < eMin32 {
+	if exp < eTiny32 || exp > eTop32 {
+		if exp < eTiny32 {
 			// If exponent is too small, try to adjust by reducing precision
 			// This is a simplification - full subnormal handling would be more complex
 			if newCoe == 0 {
@@ -233,20 +291,20 @@ func (x *X32) Round(mode Rounding, precision Precision) error {
 				exp = 0
 			} else if (newCoe % 10) == 0 {
 				// Can shift right to increase exponent
-				for exp < eMin32 && (newCoe%10) == 0 {
+				for exp < eTiny32 && (newCoe%10) == 0 {
 					newCoe /= 10
 					exp++
 				}
 			}
 
 			// If still too small, return error or set to zero
-			if exp < eMin32 {
+			if exp < eTiny32 {
 				if newCoe == 0 {
 					return x.pack(kind_finite, sign, 0, 0) // Return zero
 				}
 				return newInternalError(exp, "exponent out of range")
 			}
-		} else if exp > eMax32 {
+		} else if exp > eTop32 {
 			// If exponent is too large, return infinity
 			return x.pack(kind_infinity, sign, 0, 0)
 		}
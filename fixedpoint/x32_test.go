@@ -0,0 +1,56 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestX32Encoding checks pack and unpack against IEEE 754 decimal32 values
+// in the binary integer decimal (BID) encoding.
+func TestX32Encoding(t *testing.T) {
+	tests := []struct {
+		name string
+		bits uint32
+		kind kind
+		sign signc
+		exp  int8
+		coe  uint32
+	}{
+		{"One", 0x32800001, kind_finite, signc_positive, 0, 1},
+		{"NegativeZero", 0xB2800000, kind_finite, signc_negative, 0, 0},
+		{"Decimal", 0xB18002EE, kind_finite, signc_negative, -2, 750},
+		{"LargestSmallForm", 0x32FFFFFF, kind_finite, signc_positive, 0, 1<<23 - 1},
+		{"SmallestLargeForm", 0x6CA00000, kind_finite, signc_positive, 0, 1 << 23},
+		{"MaxCoefficient", 0x6CB8967F, kind_finite, signc_positive, 0, 9999999},
+		{"MaxFinite", 0x77F8967F, kind_finite, signc_positive, 90, 9999999},
+		{"SmallestSubnormal", 0x00000001, kind_finite, signc_positive, -101, 1},
+		{"LargestExponentZero", 0x5F800000, kind_finite, signc_positive, 90, 0},
+		{"Infinity", 0x78000000, kind_infinity, signc_positive, 0, 0},
+		{"NegativeInfinity", 0xF8000000, kind_infinity, signc_negative, 0, 0},
+		{"QuietNaN", 0x7C000000, kind_quiet, signc_positive, 0, 0},
+		{"SignalingNaN", 0xFE000000, kind_signaling, signc_negative, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := X32{tt.bits}
+			k, sign, exp, coe, err := x.unpack()
+			require.NoError(t, err)
+			assert.Equal(t, tt.kind, k)
+			assert.Equal(t, tt.sign, sign)
+			assert.Equal(t, tt.exp, exp)
+			assert.Equal(t, tt.coe, coe)
+
+			var y X32
+			require.NoError(t, y.pack(tt.kind, tt.sign, tt.exp, tt.coe))
+			assert.Equal(t, fmt.Sprintf("%#08x", tt.bits), fmt.Sprintf("%#08x", y.uint32))
+		})
+	}
+
+	var x X32
+	assert.Error(t, x.pack(kind_finite, signc_positive, 91, 1))
+	assert.Error(t, x.pack(kind_finite, signc_positive, -102, 1))
+}
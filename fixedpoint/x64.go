@@ -20,12 +20,67 @@ const (
 	eMin64 int16 = -383 // -767/2
 	// eTiny64 is the exponent of the smallest possible subnormal (Emin - (precision-1))
 	eTiny64 int16 = -398 // -383 - (16-1)
+	// eTop64 is the largest exponent that can be encoded (Emax - (precision-1))
+	eTop64 int16 = 369 // 384 - (16-1)
 	// bias64 is the value to add to decoded exponent to get encoded exponent (-Emin + precision - 1)
 	bias64 int16 = 398 // -(-383) + 16 - 1
 	// maxCoefficient64 is the maximum coefficient value (10^precision - 1)
 	maxCoefficient64 uint64 = 9999999999999999 // 10^16 - 1
+	// maxPayload64 is the maximum NaN payload value (10^(precision-1) - 1)
+	maxPayload64 uint64 = 999999999999999 // 10^15 - 1
 )
 
+// New64 returns the X64 with the value coefficient × 10^exponent,
+// negated when neg is set. It returns an error wrapping ErrOutOfRange when the
+// coefficient has more than 16 digits or the exponent cannot be encoded.
+func New64(neg bool, coefficient uint64, exponent int) (X64, error) {
+	if coefficient > maxCoefficient64 {
+		return X64{}, fmt.Errorf("coefficient %d: %w", coefficient, ErrOutOfRange)
+	}
+
+	if exponent < int(eTiny64) || exponent > int(eTop64) {
+		return X64{}, fmt.Errorf("exponent %d: %w", exponent, ErrOutOfRange)
+	}
+
+	var x X64
+	err := x.pack(kind_finite, signOf(neg), int16(exponent), coefficient)
+	return x, err
+}
+
+// Zero64 returns positive zero with an exponent of 0.
+func Zero64() X64 {
+	x, _ := New64(false, 0, 0)
+	return x
+}
+
+// One64 returns one with an exponent of 0.
+func One64() X64 {
+	x, _ := New64(false, 1, 0)
+	return x
+}
+
+// NaN64 returns a quiet NaN.
+func NaN64() X64 {
+	return newSpecial64(signc_positive, kind_quiet)
+}
+
+// Inf64 returns positive infinity if sign >= 0, negative infinity if sign < 0.
+func Inf64(sign int) X64 {
+	return newSpecial64(signOf(sign < 0), kind_infinity)
+}
+
+// Components returns the sign, coefficient, exponent and class of x.
+// For a NaN the coefficient is its payload; otherwise the coefficient and
+// exponent are zero unless x is finite.
+func (x X64) Components() (neg bool, coefficient uint64, exponent int, class Class) {
+	k, sign, exp, coe, err := x.unpack()
+	if err != nil {
+		return false, 0, 0, ClassSignalingNaN
+	}
+
+	return sign == signc_negative, coe, int(exp), Class(k)
+}
+
 func (x *X64) Pack(k kind, sign signc, exp int16, coe uint64) error {
 	if x == nil {
 		return fmt.Errorf("nil receiver")
@@ -49,19 +104,12 @@ func (x *X64) pack(k kind, sign signc, exp int16, coe uint64) error {
 		return newInternalError(coe, "coefficient overflow")
 	}
 
-	if (exp > eMax64 || exp < eMin64) && k == kind_finite {
-		return newInternalError(exp, "exponent out of range")
+	if coe > maxPayload64 && (k == kind_quiet || k == kind_signaling) {
+		return newInternalError(coe, "payload overflow")
 	}
 
-	// Check for subnormal values (non-zero coefficient with minimum exponent)
-	// and return a signaling NaN immediately
-	if k == kind_finite && exp == eMin64 && coe > 0 {
-		// Set as signaling NaN
-		x.uint64 = 0x7E00000000000000
-		if sign == signc_negative {
-			x.uint64 |= 1 << 63
-		}
-		return nil
+	if (exp > eTop64 || exp < eTiny64) && k == kind_finite {
+		return newInternalError(exp, "exponent out of range")
 	}
 
 	// Start with zero
@@ -87,13 +135,11 @@ func (x *X64) pack(k kind, sign signc, exp int16, coe uint64) error {
 			result |= coe & 0x1FFFFFFFFFFFFF
 		} else {
 			// Large coefficient - need to use alternative encoding
-			// Set first 2 bits of exp in combination field
-			result |= ((biasedExp >> 8) & 0x3) << 61
 			// Set special pattern 11 to indicate this format
-			result |= 3 << 59
-			// Set remaining 8 bits of exponent
-			result |= (biasedExp & 0xFF) << 51
-			// Set coefficient bits
+			result |= 3 << 61
+			// Exponent bits follow the pattern (10 bits)
+			result |= (biasedExp & 0x3FF) << 51
+			// Set coefficient bits; the leading 100 is implicit
 			result |= coe & 0x7FFFFFFFFFFFF
 		}
 
@@ -103,11 +149,11 @@ func (x *X64) pack(k kind, sign signc, exp int16, coe uint64) error {
 
 	case kind_quiet:
 		// Quiet NaN: G0..G4=11111, G5=0
-		result |= 0x7C00000000000000
+		result |= 0x7C00000000000000 | coe
 
 	case kind_signaling:
 		// Signaling NaN: G0..G4=11111, G5=1
-		result |= 0x7E00000000000000
+		result |= 0x7E00000000000000 | coe
 
 	default:
 		return newInternalError(k, "invalid kind")
@@ -142,11 +188,17 @@ func (x *X64) unpack() (kind, signc, int16, uint64, error) {
 		// Positive or negative infinity
 		return kind_infinity, sign, 0, 0, nil
 	case 0x1F: // 11111
-		// NaN - determine if quiet or signaling using G5 bit
+		// NaN - the trailing bits hold the payload, treated as zero if non-canonical
+		payload := bits & 0x3FFFFFFFFFFFF
+		if payload > maxPayload64 {
+			payload = 0
+		}
+
+		// Determine if quiet or signaling using G5 bit
 		if (bits>>57)&0x1 == 1 {
-			return kind_signaling, sign, 0, 0, nil
+			return kind_signaling, sign, 0, payload, nil
 		}
-		return kind_quiet, sign, 0, 0, nil
+		return kind_quiet, sign, 0, payload, nil
 	}
 
 	// Handle normal values
@@ -157,13 +209,12 @@ func (x *X64) unpack() (kind, signc, int16, uint64, error) {
 	var coe uint64
 
 	if g0g1 == 0x3 { // Large coefficient format
-		// Extract encoded exponent: 2 bits in combination field + 8 bits in exponent continuation field
-		encodedExp := int16(((bits >> 61) & 0x3) << 8)
-		encodedExp |= int16((bits >> 51) & 0xFF)
+		// Extract encoded exponent: 10 bits after the 11 pattern
+		encodedExp := int16((bits >> 51) & 0x3FF)
 		exp = encodedExp - bias64 // Remove bias to get decoded exponent
 
-		// Extract coefficient
-		coe = bits & 0x7FFFFFFFFFFFF
+		// Extract coefficient, restoring the implicit leading 100
+		coe = 1<<53 | bits&0x7FFFFFFFFFFFF
 	} else {
 		// Normal format
 		// Extract encoded exponent: 10 bits after sign
@@ -174,6 +225,11 @@ func (x *X64) unpack() (kind, signc, int16, uint64, error) {
 		coe = bits & 0x1FFFFFFFFFFFFF
 	}
 
+	// Non-canonical coefficients are treated as zero
+	if coe > maxCoefficient64 {
+		coe = 0
+	}
+
 	return kind_finite, sign, exp, coe, nil
 }
 
@@ -234,8 +290,8 @@ func (x *X64) Round(mode Rounding, prec Precision) error {
 	}
 
 	// For special cases of subnormal or extreme values
-	if exp < eMin64 || exp > eMax64 {
-		if exp < eMin64 {
+	if exp < eTiny64 || exp > eTop64 {
+		if exp < eTiny64 {
 			// If exponent is too small, try to adjust by reducing precision
 			// This is a simplification - full subnormal handling would be more complex
 			if newCoe == 0 {
@@ -243,20 +299,20 @@ func (x *X64) Round(mode Rounding, prec Precision) error {
 				exp = 0
 			} else if (newCoe % 10) == 0 {
 				// Can shift right to increase exponent
-				for exp < eMin64 && (newCoe%10) == 0 {
+				for exp < eTiny64 && (newCoe%10) == 0 {
 					newCoe /= 10
 					exp++
 				}
 			}
 
 			// If still too small, return error or set to zero
-			if exp < eMin64 {
+			if exp < eTiny64 {
 				if newCoe == 0 {
 					return x.pack(kind_finite, sign, 0, 0) // Return zero
 				}
 				return newInternalError(exp, "exponent out of range")
 			}
-		} else if exp > eMax64 {
+		} else if exp > eTop64 {
 			// If exponent is too large, return infinity
 			return x.pack(kind_infinity, sign, 0, 0)
 		}
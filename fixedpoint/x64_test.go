@@ -0,0 +1,57 @@
+package fixedpoint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestX64Encoding checks pack and unpack against IEEE 754 decimal64 values
+// in the binary integer decimal (BID) encoding.
+func TestX64Encoding(t *testing.T) {
+	tests := []struct {
+		name string
+		bits uint64
+		kind kind
+		sign signc
+		exp  int16
+		coe  uint64
+	}{
+		{"One", 0x31C0000000000001, kind_finite, signc_positive, 0, 1},
+		{"NegativeZero", 0xB1C0000000000000, kind_finite, signc_negative, 0, 0},
+		{"Decimal", 0xB180000000003039, kind_finite, signc_negative, -2, 12345},
+		{"LargestSmallForm", 0x31DFFFFFFFFFFFFF, kind_finite, signc_positive, 0, 1<<53 - 1},
+		{"SmallestLargeForm", 0x6C70000000000000, kind_finite, signc_positive, 0, 1 << 53},
+		{"MaxCoefficient", 0x6C7386F26FC0FFFF, kind_finite, signc_positive, 0, 9999999999999999},
+		{"MaxFinite", 0x77FB86F26FC0FFFF, kind_finite, signc_positive, 369, 9999999999999999},
+		{"NegativeMaxFinite", 0xF7FB86F26FC0FFFF, kind_finite, signc_negative, 369, 9999999999999999},
+		{"SmallestSubnormal", 0x0000000000000001, kind_finite, signc_positive, -398, 1},
+		{"LargestExponentZero", 0x5FE0000000000000, kind_finite, signc_positive, 369, 0},
+		{"Infinity", 0x7800000000000000, kind_infinity, signc_positive, 0, 0},
+		{"NegativeInfinity", 0xF800000000000000, kind_infinity, signc_negative, 0, 0},
+		{"QuietNaN", 0x7C00000000000000, kind_quiet, signc_positive, 0, 0},
+		{"SignalingNaN", 0xFE00000000000000, kind_signaling, signc_negative, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := X64{tt.bits}
+			k, sign, exp, coe, err := x.unpack()
+			require.NoError(t, err)
+			assert.Equal(t, tt.kind, k)
+			assert.Equal(t, tt.sign, sign)
+			assert.Equal(t, tt.exp, exp)
+			assert.Equal(t, tt.coe, coe)
+
+			var y X64
+			require.NoError(t, y.pack(tt.kind, tt.sign, tt.exp, tt.coe))
+			assert.Equal(t, fmt.Sprintf("%#016x", tt.bits), fmt.Sprintf("%#016x", y.uint64))
+		})
+	}
+
+	var x X64
+	assert.Error(t, x.pack(kind_finite, signc_positive, 370, 1))
+	assert.Error(t, x.pack(kind_finite, signc_positive, -399, 1))
+}